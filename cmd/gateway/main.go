@@ -0,0 +1,91 @@
+package main
+
+import (
+	"flag"
+	"github.com/godzilla-s/fabricsdk-go/gateway"
+	"github.com/godzilla-s/fabricsdk-go/gateway/proposalstore"
+	"github.com/godzilla-s/fabricsdk-go/gateway/server"
+	"github.com/godzilla-s/fabricsdk-go/internal/comm"
+	"github.com/godzilla-s/fabricsdk-go/network"
+	"github.com/godzilla-s/fabricsdk-go/wallet"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+func main() {
+	var config server.Config
+	var walletDir, walletAccess, profile, proposalDir, checkpointDir, gitRepoRoot string
+	flag.StringVar(&config.ListenAddress, "listen", "0.0.0.0:7060", "address the gateway grpc server listens on")
+	flag.StringVar(&config.HTTPListenAddress, "http-listen", "", "address the HTTP/JSON front-end listens on, disabled if empty")
+	flag.StringVar(&config.TLSCertFile, "tls-cert", "", "PEM-encoded tls certificate of the server, tls is disabled if empty")
+	flag.StringVar(&config.TLSKeyFile, "tls-key", "", "PEM-encoded tls private key of the server")
+	flag.StringVar(&config.TLSClientCAFile, "tls-client-ca", "", "PEM-encoded CA certificates of the clients, clients must present a certificate issued by them if set")
+	flag.IntVar(&config.MaxRecvMsgSize, "max-recv-msg-size", comm.MaxRecvMsgSize, "maximum message size in bytes the server can receive")
+	flag.IntVar(&config.MaxSendMsgSize, "max-send-msg-size", comm.MaxSendMsgSize, "maximum message size in bytes the server can send")
+	flag.StringVar(&walletDir, "wallet", "", "directory of the identity wallet, requests can refer to its identities by label")
+	flag.StringVar(&walletAccess, "wallet-access", "", "JSON file mapping identity labels to the client certificate common names allowed to use them, signing by label is disabled if empty")
+	flag.StringVar(&profile, "network", "", "connection profile (YAML or JSON), requests can refer to its peers and orderers by name or organization")
+	flag.StringVar(&proposalDir, "proposal-dir", "", "directory to persist config update proposals, proposals are kept in memory if empty")
+	flag.StringVar(&checkpointDir, "checkpoint-dir", "checkpoints", "directory to persist the checkpoints of block subscriptions, checkpoints are kept in memory if empty")
+	flag.StringVar(&gitRepoRoot, "git-repo-root", "", "directory of the local git repositories chaincode can be installed from, installing from git repositories is disabled if empty")
+	flag.Parse()
+
+	if walletDir != "" {
+		w, err := wallet.NewFileSystemWallet(walletDir)
+		if err != nil {
+			log.Fatalf("fail to open wallet: %v", err)
+		}
+		gateway.SetWallet(w)
+	}
+	if walletAccess != "" {
+		access, err := wallet.LoadAccessList(walletAccess)
+		if err != nil {
+			log.Fatalf("fail to load wallet access list: %v", err)
+		}
+		gateway.SetWalletAccess(access)
+	}
+	if profile != "" {
+		n, err := network.LoadFile(profile)
+		if err != nil {
+			log.Fatalf("fail to load connection profile: %v", err)
+		}
+		gateway.SetNetwork(n)
+	}
+	if proposalDir != "" {
+		store, err := proposalstore.NewFileStore(proposalDir)
+		if err != nil {
+			log.Fatalf("fail to open proposal store: %v", err)
+		}
+		gateway.SetProposalStore(store)
+	}
+
+	if err := gateway.SetCheckpointDir(checkpointDir); err != nil {
+		log.Fatalf("fail to set checkpoint dir: %v", err)
+	}
+	if err := gateway.SetGitRepoRoot(gitRepoRoot); err != nil {
+		log.Fatalf("fail to set git repository root: %v", err)
+	}
+
+	srv, err := server.New(config)
+	if err != nil {
+		log.Fatalf("fail to create gateway server: %v", err)
+	}
+
+	go func() {
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+		<-sigCh
+		log.Println("stopping gateway server")
+		srv.Stop()
+	}()
+
+	log.Printf("gateway server listening on %s", srv.Address())
+	if addr := srv.HTTPAddress(); addr != "" {
+		log.Printf("gateway HTTP/JSON front-end listening on %s", addr)
+	}
+	if err := srv.Start(); err != nil {
+		log.Fatalf("gateway server stopped with error: %v", err)
+	}
+}
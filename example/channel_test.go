@@ -349,6 +349,18 @@ func listChannel(org organization, expected []string) error {
 	if rsp.Status != gateway.RESPONSE_OK {
 		return fmt.Errorf("invalid response status: %d, %s", rsp.Status, rsp.Message)
 	}
+	for _, ch := range expected {
+		found := false
+		for _, c := range rsp.Channels {
+			if c == ch {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("channel %s not found in %v", ch, rsp.Channels)
+		}
+	}
 	return nil
 }
 
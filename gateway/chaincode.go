@@ -9,7 +9,7 @@ import (
 )
 
 func ChaincodeInstall(ctx context.Context, req *protoutil.ChaincodeInstallRequest) (*protoutil.ChaincodeInstallResponse, error) {
-	signer, err := createSigner(req.Signer)
+	signer, err := createSigner(ctx, req.Signer)
 	if err != nil {
 		return nil, err
 	}
@@ -18,36 +18,54 @@ func ChaincodeInstall(ctx context.Context, req *protoutil.ChaincodeInstallReques
 	if err != nil {
 		return nil, err
 	}
+	defer closePeerClients(pClients)
 
-	var chaincodeInstaller chaincode.ChaincodeInstaller
-	switch req.Chaincode.Mode {
+	chaincodeInstaller, err := getChaincodeInstaller(req.Chaincode)
+	if err != nil {
+		return nil, err
+	}
+
+	return chaincode.Install(ctx, signer, pClients, chaincodeInstaller)
+}
+
+// SetGitRepoRoot 设置 FROM_GIT_REPO 安装时允许克隆的本地仓库所在的目录，未设置时不能从git仓库安装
+func SetGitRepoRoot(root string) error {
+	return chaincode.SetGitRepoRoot(root)
+}
+
+// getChaincodeInstaller 按照打包方式获取链码安装器
+func getChaincodeInstaller(pkg *protoutil.ChaincodePackage) (chaincode.ChaincodeInstaller, error) {
+	if pkg == nil || pkg.Chaincode == nil {
+		return nil, errors.New("chaincode package is required")
+	}
+	cc := pkg.Chaincode
+	switch pkg.Mode {
 	case protoutil.ChaincodePackage_FROM_PACKAGE_BYTES:
-		pkgBytes := req.Chaincode.Chaincode.GetPkgBytes()
-		chaincodeInstaller = chaincode.GetChaincodeInstallerFromPackage(pkgBytes)
+		return chaincode.GetChaincodeInstallerFromPackage(cc.GetPkgBytes()), nil
 	case protoutil.ChaincodePackage_FROM_PACKAGE_FILE:
-		pkgFile := req.Chaincode.Chaincode.GetFile()
-		chaincodeInstaller = chaincode.GetChaincodeInstallerFromPkgFile(pkgFile)
+		return chaincode.GetChaincodeInstallerFromPkgFile(cc.GetFile()), nil
 	case protoutil.ChaincodePackage_FROM_SOURCE_CODE:
-		// TODO
-		chaincodeInstaller = chaincode.GetChaincodeInstallerFromSource("", "", "")
+		return chaincode.GetChaincodeInstallerFromSource(cc.GetSource(), cc.Path, cc.Label, cc.Lang), nil
 	case protoutil.ChaincodePackage_FROM_GIT_REPO:
-		// TODO
-		chaincodeInstaller = chaincode.GetChaincodeInstallerFromGitRepo("")
+		return chaincode.GetChaincodeInstallerFromGitRepo(cc.GetGitRepo(), cc.GitRef, cc.Path, cc.Label, cc.Lang), nil
 	}
-
-	return chaincode.Install(signer, pClients, chaincodeInstaller)
+	return nil, errors.Errorf("unsupported chaincode package mode %v", pkg.Mode)
 }
 
 // ApproveChaincode 授信链码
 func ChaincodeApprove(ctx context.Context, req *protoutil.ChaincodeApproveRequest) (*protoutil.Response, error) {
-	signer, err := createSigner(req.Signer)
+	signer, err := createSigner(ctx, req.Signer)
 	if err != nil {
 		return nil, err
 	}
-	commonFactory, err := createCommonFactory(req.Committer, []*protoutil.Peer{req.Committer}, req.Orderer)
+	commonFactory, err := createCommonFactory(ctx, req.Committer, []*protoutil.Peer{req.Committer}, req.Orderer)
 	if err != nil {
 		return nil, err
 	}
+	defer commonFactory.Close()
+	if err := setCommitNotifiers(ctx, commonFactory, signer, []*protoutil.Peer{req.Committer}, req.ChannelId); err != nil {
+		return nil, err
+	}
 	definition := &chaincode.ApproveChaincodeRequest{
 		Name: req.Definition.Name,
 		Version: req.Definition.Version,
@@ -57,24 +75,28 @@ func ChaincodeApprove(ctx context.Context, req *protoutil.ChaincodeApproveReques
 		ValidationPlugin: req.Definition.ValidatePlugin,
 		ValidationParameterBytes: req.Definition.ValidateParams,
 	}
-	resp, err := chaincode.Approve(signer, commonFactory, definition, req.ChannelId)
+	resp, err := chaincode.Approve(ctx, signer, commonFactory, definition, req.ChannelId)
 	if err != nil {
-		return nil, err
+		return errorResponse(err)
 	}
 	return &protoutil.Response{Status: resp.Response.Status}, nil
 }
 
 // CommitChaincode 提交确认链码
 func ChaincodeCommit(ctx context.Context, req *protoutil.ChaincodeCommitRequest) (*protoutil.Response, error) {
-	signer, err := createSigner(req.Signer)
+	signer, err := createSigner(ctx, req.Signer)
 	if err != nil {
 		return nil, err
 	}
 
-	commonFactory, err := createCommonFactory(nil, req.Endorsers, req.Orderer)
+	commonFactory, err := createCommonFactory(ctx, nil, req.Endorsers, req.Orderer)
 	if err != nil {
 		return nil, err
 	}
+	defer commonFactory.Close()
+	if err := setCommitNotifiers(ctx, commonFactory, signer, req.Endorsers, req.ChannelId); err != nil {
+		return nil, err
+	}
 
 	definition := &chaincode.CommitChaincodeRequest{
 		Name: req.Definition.Name,
@@ -85,51 +107,56 @@ func ChaincodeCommit(ctx context.Context, req *protoutil.ChaincodeCommitRequest)
 		ValidationPlugin: req.Definition.ValidatePlugin,
 		ValidationParameter: req.Definition.ValidateParams,
 	}
-	resp, err := chaincode.Commit(signer, commonFactory, definition, req.ChannelId)
+	resp, err := chaincode.Commit(ctx, signer, commonFactory, definition, req.ChannelId)
 	if err != nil {
-		return nil, err
+		return errorResponse(err)
 	}
 	return &protoutil.Response{Status: resp.Response.Status}, nil
 }
 
 // InvokeChaincode 调用链码
 func ChaincodeInvoke(ctx context.Context, req *protoutil.ContractInvokeRequest) (*protoutil.Response, error) {
-	signer, err := createSigner(req.Signer)
+	signer, err := createSigner(ctx, req.Signer)
 	if err != nil {
 		return nil, errors.WithMessage(err, "get signer")
 	}
-	commonFactory, err := createCommonFactory(req.Committer, req.Endorsers, req.Orderer)
+	commonFactory, err := createCommonFactory(ctx, req.Committer, req.Endorsers, req.Orderer)
 	if err != nil {
 		return nil, errors.WithMessage(err, "get common factory")
 	}
-	c, err := contract.New(signer, req.Args.Name, req.Args.Version, req.ChannelId, commonFactory)
+	defer commonFactory.Close()
+	if err := setCommitNotifiers(ctx, commonFactory, signer, req.Endorsers, req.ChannelId); err != nil {
+		return nil, err
+	}
+	c, err := contract.New(ctx, signer, req.Args.Name, req.Args.Version, req.ChannelId, commonFactory)
 	if err != nil {
 		return nil, errors.WithMessage(err, "new contract")
 	}
-	resp, err := c.Invoke(req.Args.Args)
+	resp, err := c.Invoke(ctx, req.Args.Args)
 	if err != nil {
-		return nil, errors.WithMessage(err, "invoke")
+		return errorResponse(errors.WithMessage(err, "invoke"))
 	}
-	return &protoutil.Response{Status: resp.Response.Status, Payload: []byte(resp.TxID)}, nil
+	return &protoutil.Response{Status: resp.Response.Status, Message: resp.Response.Message, Payload: []byte(resp.TxID)}, nil
 }
 
 // QueryChaincode 查询链码
-func ChaincodeQuery(ctx contract.Contract, req *protoutil.ContractQueryRequest) (*protoutil.Response, error) {
-	signer, err := createSigner(req.Signer)
+func ChaincodeQuery(ctx context.Context, req *protoutil.ContractQueryRequest) (*protoutil.Response, error) {
+	signer, err := createSigner(ctx, req.Signer)
 	if err != nil {
 		return nil, errors.WithMessage(err, "get signer")
 	}
-	commonFactory, err := createCommonFactory(req.Committer, nil, req.Orderer)
+	commonFactory, err := createCommonFactory(ctx, req.Committer, []*protoutil.Peer{req.Committer}, req.Orderer)
 	if err != nil {
 		return nil, errors.WithMessage(err, "get common factory")
 	}
-	c, err := contract.New(signer, req.Args.Name, req.Args.Version, req.ChannelId, commonFactory)
+	defer commonFactory.Close()
+	c, err := contract.New(ctx, signer, req.Args.Name, req.Args.Version, req.ChannelId, commonFactory)
 	if err != nil {
 		return nil, errors.WithMessage(err, "new contract")
 	}
-	resp, err := c.Query(req.Args.Args)
+	resp, err := c.Query(ctx, req.Args.Args)
 	if err != nil {
-		return nil, errors.WithMessage(err, "invoke")
+		return errorResponse(errors.WithMessage(err, "query"))
 	}
 
 	return &protoutil.Response{Status: resp.Response.Status, Message: resp.Response.Message, Payload: resp.Response.Payload}, nil
@@ -6,35 +6,29 @@ import (
 	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
 	"github.com/godzilla-s/fabricsdk-go/internal/channel"
 	"github.com/godzilla-s/fabricsdk-go/internal/client/orderer"
-	"github.com/godzilla-s/fabricsdk-go/internal/client/peer"
 	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
-	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
 	"github.com/pkg/errors"
+	"strings"
+	"time"
 )
 
 // ChannelCreate is interface to create channel in fabric network
 func ChannelCreate(ctx context.Context, req *protoutil.CreateChannelRequest) (*protoutil.Response, error)  {
-	signer, err := createSigner(req.Signer)
+	signer, err := createSigner(ctx, req.Signer)
 	if err != nil {
 		return nil, errors.WithMessage(err, "create signer")
 	}
 
-	oClient, err := orderer.New(req.Orderer.Url, req.Orderer.HostName, req.Orderer.TlsRootCert)
+	oClient, err := newOrdererClient(req.Orderer)
 	if err != nil {
 		return nil, err
 	}
+	defer oClient.Close()
 
 	channelOrgs := make([]channel.Organization, len(req.Members))
 	for i, org := range req.Members {
-		rootCA, _ := cryptoutil.GetCertFromPEM(org.RootCert)
-		tlsRootCA,  _ := cryptoutil.GetCertFromPEM(org.TlsRootCert)
-		channelOrgs[i] = channel.Organization{
-			Name: org.Name,
-			ID: org.MspId,
-			Type: org.Type,
-			RootCA: rootCA,
-			TLSRootCA: tlsRootCA,
-		}
+		channelOrgs[i] = createChannelOrg(org)
 	}
 
 	channelEnvelope, err := channel.CreateApplicationChannel(req.ChannelId, req.ConsortiumName, channelOrgs)
@@ -42,131 +36,279 @@ func ChannelCreate(ctx context.Context, req *protoutil.CreateChannelRequest) (*p
 		return nil, errors.WithMessage(err, "create application channel")
 	}
 
-	err = channel.Create(signer, channelEnvelope, oClient)
+	err = channel.Create(ctx, signer, channelEnvelope, oClient)
 	if err != nil {
-		return &protoutil.Response{Status: 500, Message: err.Error()}, nil
+		return failResponse(err), nil
 	}
-	return &protoutil.Response{Status: 200}, nil
+
+	// 创建通道的交易不能携带锚节点，签名者所在组织的锚节点在通道创建后更新，
+	// 其他组织的锚节点需要由其管理员通过 Channel_SetAnchorPeers 提案设置
+	resp := &protoutil.Response{Status: 200}
+	var pending []string
+	for _, org := range channelOrgs {
+		if len(org.AnchorPeers) == 0 {
+			continue
+		}
+		if org.ID != signer.GetMSPId() {
+			pending = append(pending, org.ID)
+			continue
+		}
+		if err := setCreatedChannelAnchorPeers(ctx, signer, oClient, req.ChannelId, org); err != nil {
+			return failResponse(errors.WithMessagef(err, "channel %s is created, but anchor peers of %s are not set", req.ChannelId, org.ID)), nil
+		}
+	}
+	if len(pending) > 0 {
+		resp.Message = fmt.Sprintf("anchor peers of %s should be set by their admins", strings.Join(pending, ", "))
+	}
+	return resp, nil
 }
 
-// ChannelJoin is API for peer to join to channel
-func ChannelJoin(ctx context.Context, req *protoutil.JoinChannelRequest) (*protoutil.Response, error) {
-	signer, err := createSigner(req.Signer)
+// setCreatedChannelAnchorPeers waits for the config block of the created
+// channel and sets the anchor peers of the organization of signer
+func setCreatedChannelAnchorPeers(ctx context.Context, signer cryptoutil.Signer, oClient orderer.Client, channelID string, org channel.Organization) error {
+	var (
+		block *cb.Block
+		err   error
+	)
+	for i := 0; i < commitPollTimes; i++ {
+		if block, err = channel.FetchConfig(ctx, signer, oClient, channelID); err == nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(commitPollInterval):
+		}
+	}
+	if err != nil {
+		return errors.WithMessage(err, "fetch config of created channel")
+	}
+	updateEnvelope, err := channel.AnchorPeersUpdate(block, org.Name, channel.SetAnchorPeers, org.AnchorPeers, channelID)
+	if err != nil {
+		return err
+	}
+	return channel.Update(ctx, signer, updateEnvelope, oClient)
+}
+
+// ChannelJoin is API for peers to join to channel, all peers are joined
+// concurrently and the result of every peer is reported
+func ChannelJoin(ctx context.Context, req *protoutil.JoinChannelRequest) (*protoutil.JoinChannelResponse, error) {
+	signer, err := createSigner(ctx, req.Signer)
 	if err != nil {
 		return nil, errors.WithMessage(err, "create signer")
 	}
-	oClient, err := orderer.New(req.Orderer.Url, req.Orderer.HostName, req.Orderer.TlsRootCert)
+	oClient, err := newOrdererClient(req.Orderer)
 	if err != nil {
 		return nil, errors.WithMessage(err, "create orderer client")
 	}
+	defer oClient.Close()
 	pClients, err := createPeerClients(req.Peers)
 	if err != nil {
 		return nil, err
 	}
+	defer closePeerClients(pClients)
 
-	rsp, err := channel.Join2(signer, pClients[0], oClient, req.ChannelId)
+	results, err := channel.Join(ctx, signer, pClients, oClient, req.ChannelId)
 	if err != nil {
-		return nil, errors.WithMessage(err, "join peer")
+		resp, err := errorResponse(errors.WithMessage(err, "join peers"))
+		if err != nil {
+			return nil, err
+		}
+		return &protoutil.JoinChannelResponse{Status: resp.Status, Message: resp.Message, ErrorCode: resp.ErrorCode, ErrorDetails: resp.ErrorDetails}, nil
 	}
 
-	return &protoutil.Response{
-		Status: rsp.Status,
-		Message: rsp.Message,
-		Payload: rsp.Payload,
-	}, nil
+	resp := &protoutil.JoinChannelResponse{Status: RESPONSE_OK}
+	failed := 0
+	for _, r := range results {
+		pr := &protoutil.PeerJoinResult{
+			Address:       r.Address,
+			Status:        r.Status,
+			Message:       r.Message,
+			AlreadyJoined: r.AlreadyJoined,
+		}
+		if r.Err != nil {
+			failed++
+			pr.ErrorCode, pr.ErrorDetails = ErrorDetails(r.Err)
+		}
+		resp.Results = append(resp.Results, pr)
+	}
+	if failed > 0 {
+		resp.Status = RESPONSE_FAIL
+		resp.Message = fmt.Sprintf("%d of %d peers failed to join channel %s", failed, len(results), req.ChannelId)
+	}
+	return resp, nil
 }
 
 // ChannelUpdate is API for update channel config
 func ChannelUpdate(ctx context.Context, req *protoutil.UpdateChannelRequest) (*protoutil.Response, error) {
-	signer, err := createSigner(req.Signer)
+	signer, err := createSigner(ctx, req.Signer)
 	if err != nil {
 		return nil, err
 	}
 
-	oClient, err := orderer.New(req.Orderer.Url, req.Orderer.HostName, req.Orderer.TlsRootCert)
+	oClient, err := newOrdererClient(req.Orderer)
 	if err != nil {
 		return nil, err
 	}
+	defer oClient.Close()
 
 	updateEnvelope := channel.NewChannelFromBytes(req.ChannelId, req.UpdateEnvelope)
-	err = channel.Update(signer, updateEnvelope, oClient)
+	err = channel.Update(ctx, signer, updateEnvelope, oClient)
 	if err != nil {
-		return nil, err
+		return errorResponse(err)
 	}
 	return &protoutil.Response{Status: 200}, nil
 }
 
 // ChannelList is API to list channel of peer node
-func ChannelList(ctx context.Context, req *protoutil.ListChannelsRequest) (*protoutil.Response, error) {
-	signer, err := createSigner(req.Signer)
+func ChannelList(ctx context.Context, req *protoutil.ListChannelsRequest) (*protoutil.ListChannelsResponse, error) {
+	signer, err := createSigner(ctx, req.Signer)
 	if err != nil {
 		return nil, err
 	}
-	pClient, err := peer.New(req.Peer.Url, req.Peer.HostName, req.Peer.TlsRootCert)
+	pClient, err := newPeerClient(req.Peer)
 	if err != nil {
 		return nil, err
 	}
-	channelRsp, err := channel.List(signer, pClient)
+	defer pClient.Close()
+	channelRsp, err := channel.List(ctx, signer, pClient)
 	if err != nil {
-		return nil, err
+		resp, err := errorResponse(err)
+		if err != nil {
+			return nil, err
+		}
+		return &protoutil.ListChannelsResponse{Status: resp.Status, Message: resp.Message, ErrorCode: resp.ErrorCode, ErrorDetails: resp.ErrorDetails}, nil
 	}
-	resp := &protoutil.Response{}
-	var channels []string
 
+	resp := &protoutil.ListChannelsResponse{Status: 200}
 	for _, c := range channelRsp.Channels {
-		channels = append(channels, c.ChannelId)
+		resp.Channels = append(resp.Channels, c.ChannelId)
 	}
-
-	fmt.Println("channel:", channels)
-
-	//resp.Payload, _ = proto.Marshal(channels)
-	resp.Status = 200
 	return resp, nil
 }
 
-func FetchBlock(ctx context.Context, req *protoutil.FetchBlockRequest) (*protoutil.Response, error) {
-	// TODO
-	signer, err := createSigner(req.Signer)
+// FetchBlock is API to fetch block of specified height from orderer
+func FetchBlock(ctx context.Context, req *protoutil.FetchBlockRequest) (*protoutil.FetchBlockResponse, error) {
+	signer, err := createSigner(ctx, req.Signer)
 	if err != nil {
 		return nil, err
 	}
-	oClient, err := orderer.New(req.Orderer.Url, req.Orderer.HostName, req.Orderer.TlsRootCert)
+	oClient, err := newOrdererClient(req.Orderer)
 	if err != nil {
 		return nil, err
 	}
-	block, err := channel.FetchBlock(signer, oClient, req.ChannelId, uint64(req.Height))
+	defer oClient.Close()
+	block, err := channel.FetchBlock(ctx, signer, oClient, req.ChannelId, uint64(req.Height))
+	if err != nil {
+		resp, err := errorResponse(err)
+		if err != nil {
+			return nil, err
+		}
+		return &protoutil.FetchBlockResponse{Status: resp.Status, Message: resp.Message, ErrorCode: resp.ErrorCode, ErrorDetails: resp.ErrorDetails}, nil
+	}
+
+	number, hash, blockBytes, err := marshalBlock(block)
 	if err != nil {
 		return nil, err
 	}
+	return &protoutil.FetchBlockResponse{Status: 200, Number: number, Hash: hash, Block: blockBytes}, nil
+}
 
-	blockBytes, err := proto.Marshal(block)
+// FetchConfig is API to fetch the latest config block of channel from orderer
+func FetchConfig(ctx context.Context, req *protoutil.FetchConfigRequest) (*protoutil.FetchConfigResponse, error) {
+	signer, err := createSigner(ctx, req.Signer)
+	if err != nil {
+		return nil, err
+	}
+	oClient, err := newOrdererClient(req.Orderer)
+	if err != nil {
+		return nil, err
+	}
+	defer oClient.Close()
+	config, err := channel.FetchConfig(ctx, signer, oClient, req.ChannelId)
+	if err != nil {
+		resp, err := errorResponse(err)
+		if err != nil {
+			return nil, err
+		}
+		return &protoutil.FetchConfigResponse{Status: resp.Status, Message: resp.Message, ErrorCode: resp.ErrorCode, ErrorDetails: resp.ErrorDetails}, nil
+	}
 
+	number, hash, blockBytes, err := marshalBlock(config)
 	if err != nil {
 		return nil, err
 	}
-	return &protoutil.Response{Payload: blockBytes, Status: 200}, nil
+	return &protoutil.FetchConfigResponse{Status: 200, Number: number, Hash: hash, Block: blockBytes}, nil
 }
 
-func FetchConfig(ctx context.Context, req *protoutil.FetchConfigRequest) (*protoutil.Response, error) {
-	signer, err := createSigner(req.Signer)
+// QueryBlock is API to query block from the ledger of peer by number, hash or transaction id
+func QueryBlock(ctx context.Context, req *protoutil.QueryBlockRequest) (*protoutil.QueryBlockResponse, error) {
+	signer, err := createSigner(ctx, req.Signer)
 	if err != nil {
 		return nil, err
 	}
-	oClient, err := orderer.New(req.Orderer.Url, req.Orderer.HostName, req.Orderer.TlsRootCert)
+	pClient, err := newPeerClient(req.Peer)
 	if err != nil {
 		return nil, err
 	}
-	config, err := channel.FetchConfig(signer, oClient, req.ChannelId)
+	defer pClient.Close()
+
+	var block *cb.Block
+	switch cond := req.Condition.(type) {
+	case *protoutil.QueryBlockRequest_Number:
+		if cond.Number < 0 {
+			return nil, errors.Errorf("invalid block number %d", cond.Number)
+		}
+		block, err = channel.GetBlockByNumber(ctx, signer, pClient, req.ChannelId, uint64(cond.Number))
+	case *protoutil.QueryBlockRequest_Hash:
+		block, err = channel.GetBlockByHash(ctx, signer, pClient, req.ChannelId, cond.Hash)
+	case *protoutil.QueryBlockRequest_TxId:
+		block, err = channel.GetBlockByTxID(ctx, signer, pClient, req.ChannelId, cond.TxId)
+	default:
+		return nil, errors.New("query condition is required")
+	}
+	if err != nil {
+		resp := &protoutil.QueryBlockResponse{Status: RESPONSE_FAIL, Message: err.Error()}
+		resp.ErrorCode, resp.ErrorDetails = ErrorDetails(err)
+		return resp, nil
+	}
+
+	number, hash, blockBytes, err := marshalBlock(block)
 	if err != nil {
 		return nil, err
 	}
+	return &protoutil.QueryBlockResponse{Status: 200, Number: number, Hash: hash, Block: blockBytes}, nil
+}
 
-	resp := &protoutil.Response{}
-	resp.Payload, err = proto.Marshal(config)
+// GetChannelInfo is API to get height and current/previous block hash of channel from the ledger of peer
+func GetChannelInfo(ctx context.Context, req *protoutil.GetChannelInfoRequest) (*protoutil.GetChannelInfoResponse, error) {
+	signer, err := createSigner(ctx, req.Signer)
 	if err != nil {
 		return nil, err
 	}
-	resp.Status = 200
+	pClient, err := newPeerClient(req.Peer)
+	if err != nil {
+		return nil, err
+	}
+	defer pClient.Close()
+
+	info, err := channel.GetInfo(ctx, signer, pClient, req.ChannelId)
+	if err != nil {
+		resp := &protoutil.GetChannelInfoResponse{Status: RESPONSE_FAIL, Message: err.Error()}
+		resp.ErrorCode, resp.ErrorDetails = ErrorDetails(err)
+		return resp, nil
+	}
+
+	resp := &protoutil.GetChannelInfoResponse{
+		Status:            200,
+		Height:            info.Height,
+		CurrentBlockHash:  info.CurrentBlockHash,
+		PreviousBlockHash: info.PreviousBlockHash,
+	}
+	if info.BootstrappingSnapshotInfo != nil {
+		resp.BootstrappingSnapshotInfo = &protoutil.BootstrappingSnapshotInfo{
+			LastBlockInSnapshot: info.BootstrappingSnapshotInfo.LastBlockInSnapshot,
+		}
+	}
 	return resp, nil
 }
-
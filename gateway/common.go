@@ -1,13 +1,20 @@
 package gateway
 
 import (
+	"context"
 	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
 	"github.com/godzilla-s/fabricsdk-go/internal/chaincode"
 	"github.com/godzilla-s/fabricsdk-go/internal/channel"
+	"github.com/godzilla-s/fabricsdk-go/internal/client"
 	orderercli "github.com/godzilla-s/fabricsdk-go/internal/client/orderer"
 	peercli "github.com/godzilla-s/fabricsdk-go/internal/client/peer"
+	"github.com/godzilla-s/fabricsdk-go/internal/comm"
 	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/utils"
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
 	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/pkg/errors"
 )
 
 const (
@@ -15,56 +22,95 @@ const (
 	RESPONSE_FAIL = 500
 )
 
-func createSigner(signer *protoutil.Signer) (cryptoutil.Signer, error) {
-	cs, err := cryptoutil.GetMyCryptoSuiteFromBytes(signer.Key, signer.Cert, signer.MspId)
+
+
+// connPool shares grpc connections to peers and orderers across gateway calls
+var connPool = comm.NewConnectionPool()
+
+// CloseConnections closes the shared commit notifiers and the pooled
+// connections to peers and orderers
+func CloseConnections() {
+	commitNotifiers.Close()
+	connPool.Close()
+}
+
+func newPeerClient(p *protoutil.Peer) (peercli.Client, error) {
+	p, err := resolvePeer(p)
 	if err != nil {
 		return nil, err
 	}
-	return cs.NewSigner()
+	return peercli.New(p.Url, p.HostName, p.TlsRootCert, client.WithConnectionPool(connPool))
 }
 
+func newOrdererClient(o *protoutil.Orderer) (orderercli.Client, error) {
+	o, err := resolveOrderer(o)
+	if err != nil {
+		return nil, err
+	}
+	return orderercli.New(o.Url, o.HostName, o.TlsRootCert, client.WithConnectionPool(connPool))
+}
 
 func createPeerClients(peers []*protoutil.Peer) ([]peercli.Client, error) {
-	pClients := make([]peercli.Client, len(peers))
-	for i, p := range peers {
-		peerCli, err := peercli.New(p.Url, p.HostName, p.TlsRootCert)
+	peers, err := resolvePeers(peers)
+	if err != nil {
+		return nil, err
+	}
+	pClients := make([]peercli.Client, 0, len(peers))
+	for _, p := range peers {
+		peerCli, err := newPeerClient(p)
 		if err != nil {
+			closePeerClients(pClients)
 			return nil, err
 		}
-		pClients[i] = peerCli
+		pClients = append(pClients, peerCli)
 	}
 	return pClients, nil
 }
 
-func createCommonFactory(commiter *protoutil.Peer, endorsers []*protoutil.Peer, ord *protoutil.Orderer) (*chaincode.CommonFactory, error) {
-	cf := &chaincode.CommonFactory{}
-	commitCli, err := peercli.New(commiter.Url, commiter.HostName, commiter.TlsRootCert)
-	if err != nil {
-		return nil, err
+func closePeerClients(pClients []peercli.Client) {
+	for _, c := range pClients {
+		c.Close()
 	}
-	ordererCli, err := orderercli.New(ord.Url, ord.HostName, ord.TlsRootCert)
-	if err != nil {
-		return nil, err
+}
+
+func createCommonFactory(ctx context.Context, commiter *protoutil.Peer, endorsers []*protoutil.Peer, ord *protoutil.Orderer) (_ *chaincode.CommonFactory, err error) {
+	cf := &chaincode.CommonFactory{}
+	defer func() {
+		if err != nil {
+			cf.Close()
+		}
+	}()
+	if commiter != nil {
+		commitCli, err := newPeerClient(commiter)
+		if err != nil {
+			return nil, err
+		}
+		cf.PeerClients = append(cf.PeerClients, commitCli)
+		cf.Committer, err = commitCli.GetEndorser(ctx)
+		if err != nil {
+			return nil, err
+		}
+		cf.TLSCert = commitCli.GetCertificate()
 	}
-	cf.Committer, err = commitCli.GetEndorser()
-	cf.TLSCert = commitCli.GetCertificate()
+	cf.OClient, err = newOrdererClient(ord)
 	if err != nil {
 		return nil, err
 	}
-	cf.OClient = ordererCli
-	cf.Endorsers = make([]peer.EndorserClient, len(endorsers))
-	cf.PeerAddresses = make([]string, len(endorsers))
 	endorserClients, err := createPeerClients(endorsers)
 	if err != nil {
 		return nil, err
 	}
+	cf.Endorsers = make([]peer.EndorserClient, len(endorserClients))
+	cf.Delivers = make([]peer.DeliverClient, len(endorserClients))
+	cf.PeerAddresses = make([]string, len(endorserClients))
+	cf.PeerClients = append(cf.PeerClients, endorserClients...)
 	for i, e := range endorserClients {
-		endorser, err := e.GetEndorser()
+		endorser, err := e.GetEndorser(ctx)
 		if err != nil {
 			return nil, err
 		}
 		cf.Endorsers[i] = endorser
-		deliver, err := e.GetDeliverClient()
+		deliver, err := e.GetDeliverClient(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -83,5 +129,26 @@ func createChannelOrg(org *protoutil.Organization) channel.Organization {
 		Type: org.Type,
 		RootCA: rootCA,
 		TLSRootCA: tlsRootCA,
+		AnchorPeers: createAnchorPeers(org.AnchorPeers),
 	}
-}
\ No newline at end of file
+}
+
+func createAnchorPeers(anchorPeers []*protoutil.AnchorPeer) []channel.AnchorPeer {
+	peers := make([]channel.AnchorPeer, len(anchorPeers))
+	for i, ap := range anchorPeers {
+		peers[i] = channel.AnchorPeer{Host: ap.Host, Port: int(ap.Port)}
+	}
+	return peers
+}
+
+// marshalBlock returns the number, header hash and marshaled bytes of block
+func marshalBlock(block *cb.Block) (uint64, []byte, []byte, error) {
+	if block.Header == nil {
+		return 0, nil, nil, errors.New("block header is nil")
+	}
+	blockBytes, err := proto.Marshal(block)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return block.Header.Number, utils.BlockHeaderHash(block.Header), blockBytes, nil
+}
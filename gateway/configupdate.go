@@ -0,0 +1,127 @@
+package gateway
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/channel"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/hyperledger/fabric-config/configtx"
+	"github.com/hyperledger/fabric-config/configtx/orderer"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// createConfigModification converts the config modification of a proposal
+func createConfigModification(m *protoutil.ConfigModification) (channel.ConfigModification, error) {
+	mod := channel.ConfigModification{
+		ACLs:        m.Acls,
+		RemovedACLs: m.RemovedAcls,
+	}
+	if m.BatchSize != nil {
+		mod.BatchSize = orderer.BatchSize{
+			MaxMessageCount:   m.BatchSize.MaxMessageCount,
+			AbsoluteMaxBytes:  m.BatchSize.AbsoluteMaxBytes,
+			PreferredMaxBytes: m.BatchSize.PreferredMaxBytes,
+		}
+	}
+	if m.BatchTimeout != "" {
+		timeout, err := time.ParseDuration(m.BatchTimeout)
+		if err != nil {
+			return mod, errors.Wrapf(err, "invalid batch timeout %q", m.BatchTimeout)
+		}
+		if timeout <= 0 {
+			return mod, errors.Errorf("invalid batch timeout %q", m.BatchTimeout)
+		}
+		mod.BatchTimeout = timeout
+	}
+	for _, p := range m.Policies {
+		mod.Policies = append(mod.Policies, channel.PolicyUpdate{
+			Group:   channel.ConfigGroup(p.Group),
+			OrgName: p.OrgName,
+			Name:    p.Name,
+			Policy:  configtx.Policy{Type: p.Type, Rule: p.Rule, ModPolicy: p.ModPolicy},
+			Remove:  p.Remove,
+		})
+	}
+	for _, c := range m.Capabilities {
+		mod.Capabilities = append(mod.Capabilities, channel.CapabilityUpdate{
+			Group:      channel.ConfigGroup(c.Group),
+			Capability: c.Capability,
+			Remove:     c.Remove,
+		})
+	}
+	for _, u := range m.Msps {
+		mspUpdate, err := createMSPUpdate(u)
+		if err != nil {
+			return mod, errors.WithMessagef(err, "MSP update of organization %s", u.OrgName)
+		}
+		mod.MSPs = append(mod.MSPs, mspUpdate)
+	}
+	return mod, nil
+}
+
+func createMSPUpdate(u *protoutil.MSPUpdate) (channel.MSPUpdate, error) {
+	mspUpdate := channel.MSPUpdate{Group: channel.ConfigGroup(u.Group), OrgName: u.OrgName}
+	fields := []struct {
+		pems  [][]byte
+		certs *[]*x509.Certificate
+	}{
+		{u.AddedRootCerts, &mspUpdate.AddedRootCerts},
+		{u.RemovedRootCerts, &mspUpdate.RemovedRootCerts},
+		{u.AddedIntermediateCerts, &mspUpdate.AddedIntermediateCerts},
+		{u.RemovedIntermediateCerts, &mspUpdate.RemovedIntermediateCerts},
+		{u.AddedAdminCerts, &mspUpdate.AddedAdminCerts},
+		{u.RemovedAdminCerts, &mspUpdate.RemovedAdminCerts},
+		{u.AddedTlsRootCerts, &mspUpdate.AddedTLSRootCerts},
+		{u.RemovedTlsRootCerts, &mspUpdate.RemovedTLSRootCerts},
+		{u.AddedTlsIntermediateCerts, &mspUpdate.AddedTLSIntermediateCerts},
+		{u.RemovedTlsIntermediateCerts, &mspUpdate.RemovedTLSIntermediateCerts},
+	}
+	for _, f := range fields {
+		for _, pem := range f.pems {
+			cert, err := cryptoutil.GetCertFromPEM(pem)
+			if err != nil {
+				return mspUpdate, err
+			}
+			*f.certs = append(*f.certs, cert)
+		}
+	}
+	for _, pem := range u.Crls {
+		crl, err := x509.ParseCRL(pem)
+		if err != nil {
+			return mspUpdate, errors.Wrap(err, "parse CRL")
+		}
+		mspUpdate.CRLs = append(mspUpdate.CRLs, (*pkix.CertificateList)(crl))
+	}
+	return mspUpdate, nil
+}
+
+// createConsenter converts the consenter of a proposal, the TLS certs are not
+// required to remove a consenter
+func createConsenter(c *protoutil.Consenter, withCerts bool) (channel.Consenter, error) {
+	consenter := channel.Consenter{Host: c.Host, Port: int(c.Port)}
+	if !withCerts {
+		return consenter, nil
+	}
+	serverCert, err := cryptoutil.GetCertFromPEM(c.ServerTlsCert)
+	if err != nil {
+		return consenter, errors.WithMessagef(err, "server TLS cert of consenter %s:%d", c.Host, c.Port)
+	}
+	clientCert, err := cryptoutil.GetCertFromPEM(c.ClientTlsCert)
+	if err != nil {
+		return consenter, errors.WithMessagef(err, "client TLS cert of consenter %s:%d", c.Host, c.Port)
+	}
+	consenter.ServerTLSCert, consenter.ClientTLSCert = *serverCert, *clientCert
+	return consenter, nil
+}
+
+func createRaftOptions(o *protoutil.RaftOptions) orderer.EtcdRaftOptions {
+	return orderer.EtcdRaftOptions{
+		TickInterval:         o.TickInterval,
+		ElectionTick:         o.ElectionTick,
+		HeartbeatTick:        o.HeartbeatTick,
+		MaxInflightBlocks:    o.MaxInflightBlocks,
+		SnapshotIntervalSize: o.SnapshotIntervalSize,
+	}
+}
@@ -0,0 +1,396 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/chaincode"
+	peercli "github.com/godzilla-s/fabricsdk-go/internal/client/peer"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/pkg/errors"
+	"strings"
+	"time"
+)
+
+const (
+	deployPollInterval   = time.Second
+	deployApproveTimeout = 60 * time.Second
+	deployCommitTimeout  = 30 * time.Second
+)
+
+// 部署的步骤
+const (
+	DEPLOY_STEP_INSTALL = "install"
+	DEPLOY_STEP_APPROVE = "approve"
+	DEPLOY_STEP_COMMIT  = "commit"
+	DEPLOY_STEP_INIT    = "init"
+)
+
+// deployOrg 部署链码的组织
+type deployOrg struct {
+	mspID  string
+	signer cryptoutil.Signer
+	peers  []*protoutil.Peer
+}
+
+type deployment struct {
+	req        *protoutil.ChaincodeDeployRequest
+	orgs       []*deployOrg
+	packageID  string
+	pkgBytes   []byte
+	definition *chaincode.CommitChaincodeRequest
+	resp       *protoutil.ChaincodeDeployResponse
+}
+
+// ChaincodeDeploy 在各组织的节点上安装链码，各组织授信后提交链码定义，需要时调用Init；
+// 已完成的步骤会被跳过，部分失败后可以重复调用
+func ChaincodeDeploy(ctx context.Context, req *protoutil.ChaincodeDeployRequest) (*protoutil.ChaincodeDeployResponse, error) {
+	if len(req.Orgs) == 0 {
+		return nil, errors.New("at least one organization is required")
+	}
+	if req.Definition == nil || req.Definition.Name == "" {
+		return nil, errors.New("chaincode definition with name is required")
+	}
+	installer, err := getChaincodeInstaller(req.Chaincode)
+	if err != nil {
+		return nil, err
+	}
+	args, err := installer.GetInstalledChaincode()
+	if err != nil {
+		return nil, errors.WithMessage(err, "package chaincode")
+	}
+	packageID, err := chaincode.PackageID(args.ChaincodeInstallPackage)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &deployment{
+		req:       req,
+		packageID: packageID,
+		pkgBytes:  args.ChaincodeInstallPackage,
+		definition: &chaincode.CommitChaincodeRequest{
+			Name:                req.Definition.Name,
+			Version:             req.Definition.Version,
+			Sequence:            req.Definition.Sequence,
+			SignPolicy:          req.SignaturePolicy,
+			ChannelConfigPolicy: req.ChannelConfigPolicy,
+			EndorsementPlugin:   req.Definition.EndorsePlugin,
+			ValidationPlugin:    req.Definition.ValidatePlugin,
+			InitRequired:        req.Definition.InitRequired,
+			CollectionConfig:    req.CollectionConfig,
+		},
+		resp: &protoutil.ChaincodeDeployResponse{Status: RESPONSE_OK, PackageId: packageID},
+	}
+	for i, org := range req.Orgs {
+		if len(org.Peers) == 0 {
+			return nil, errors.Errorf("organization %d has no peers", i)
+		}
+		signer, err := createSigner(ctx, org.Signer)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "signer of organization %d", i)
+		}
+		d.orgs = append(d.orgs, &deployOrg{mspID: signer.GetMSPId(), signer: signer, peers: org.Peers})
+	}
+
+	for _, org := range d.orgs {
+		if err := d.install(ctx, org); err != nil {
+			return d.resp, nil
+		}
+	}
+
+	// 提交和初始化使用第一个组织的管理员，背书节点为各组织的第一个节点
+	endorsers := make([]*protoutil.Peer, len(d.orgs))
+	for i, org := range d.orgs {
+		endorsers[i] = org.peers[0]
+	}
+	cf, err := createCommonFactory(ctx, endorsers[0], endorsers, req.Orderer)
+	if err != nil {
+		return nil, err
+	}
+	defer cf.Close()
+	// 提交和初始化通过共享的提交通知器等待交易提交
+	if err := setCommitNotifiers(ctx, cf, d.orgs[0].signer, endorsers, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	committed, err := d.resolveSequence(ctx, cf)
+	if err != nil {
+		d.fail(DEPLOY_STEP_COMMIT, req.ChannelId, err)
+		return d.resp, nil
+	}
+	if committed {
+		for _, org := range d.orgs {
+			d.skip(DEPLOY_STEP_APPROVE, org.mspID, "definition is already committed")
+		}
+		d.skip(DEPLOY_STEP_COMMIT, req.ChannelId, fmt.Sprintf("definition is already committed at sequence %d", d.definition.Sequence))
+	} else {
+		if err := d.approve(ctx, cf); err != nil {
+			return d.resp, nil
+		}
+		if err := d.commit(ctx, cf); err != nil {
+			return d.resp, nil
+		}
+	}
+
+	if d.definition.InitRequired {
+		d.init(ctx, cf)
+	}
+	return d.resp, nil
+}
+
+// install 在组织中未安装该链码包的节点上安装
+func (d *deployment) install(ctx context.Context, org *deployOrg) error {
+	pClients, err := createPeerClients(org.peers)
+	if err != nil {
+		return d.fail(DEPLOY_STEP_INSTALL, org.mspID, err)
+	}
+	defer closePeerClients(pClients)
+
+	var missing []peercli.Client
+	for _, pc := range pClients {
+		installed, err := d.installed(ctx, org.signer, pc)
+		if err != nil {
+			return d.fail(DEPLOY_STEP_INSTALL, pc.GetAddress(), err)
+		}
+		if installed {
+			d.skip(DEPLOY_STEP_INSTALL, pc.GetAddress(), "package is already installed")
+			continue
+		}
+		missing = append(missing, pc)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	result, err := chaincode.Install(ctx, org.signer, missing, chaincode.GetChaincodeInstallerFromPackage(d.pkgBytes))
+	if err != nil {
+		return d.fail(DEPLOY_STEP_INSTALL, org.mspID, err)
+	}
+	var failed error
+	for _, r := range result.Results {
+		d.resp.Steps = append(d.resp.Steps, &protoutil.DeployStep{Name: DEPLOY_STEP_INSTALL, Target: r.Id, Status: r.Status, Message: r.Message})
+		if r.Status != RESPONSE_OK && failed == nil {
+			failed = errors.Errorf("install on %s failed with status %d - %s", r.Id, r.Status, r.Message)
+		}
+	}
+	if failed != nil {
+		d.resp.Status = RESPONSE_FAIL
+		d.resp.Message = failed.Error()
+	}
+	return failed
+}
+
+func (d *deployment) installed(ctx context.Context, signer cryptoutil.Signer, pc peercli.Client) (bool, error) {
+	endorser, err := pc.GetEndorser(ctx)
+	if err != nil {
+		return false, err
+	}
+	list, err := chaincode.QueryInstalled(ctx, signer, endorser)
+	if err != nil {
+		return false, errors.WithMessage(err, "query installed chaincodes")
+	}
+	for _, cc := range list.InstalledChaincodes {
+		if cc.PackageId == d.packageID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// resolveSequence 根据通道上已提交的定义确定sequence，定义已提交并且各组织授信的是同一个链码包时返回true
+func (d *deployment) resolveSequence(ctx context.Context, cf *chaincode.CommonFactory) (bool, error) {
+	list, err := chaincode.QueryCommitted(ctx, d.orgs[0].signer, cf.Committer, d.req.ChannelId)
+	if err != nil {
+		return false, errors.WithMessage(err, "query committed chaincodes")
+	}
+	committed, err := chaincode.ResolveSequence(list, d.definition, d.packageID, func(sequence int64) ([]string, error) {
+		return d.approvedPackages(ctx, sequence)
+	})
+	if err != nil {
+		return false, err
+	}
+	d.resp.Sequence = d.definition.Sequence
+	return committed, nil
+}
+
+// approvedPackages 查询各组织在 sequence 授信的链码包，组织在 sequence 没有授信时链码包为空，
+// 与部署的链码包不一致
+func (d *deployment) approvedPackages(ctx context.Context, sequence int64) ([]string, error) {
+	packages := make([]string, len(d.orgs))
+	for i, org := range d.orgs {
+		pClient, err := newPeerClient(org.peers[0])
+		if err != nil {
+			return nil, err
+		}
+		endorser, err := pClient.GetEndorser(ctx)
+		if err != nil {
+			pClient.Close()
+			return nil, err
+		}
+		result, err := chaincode.QueryApproved(ctx, org.signer, endorser, d.definition.Name, d.req.ChannelId, chaincode.WithSequence(sequence))
+		pClient.Close()
+		if errors.Is(err, chaincode.ErrNotApproved) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.WithMessagef(err, "query approved definition of %s", org.mspID)
+		}
+		packages[i] = result.GetSource().GetLocalPackage().GetPackageId()
+	}
+	return packages, nil
+}
+
+// approve 尚未授信的组织授信链码定义，并等待所有组织的授信生效
+func (d *deployment) approve(ctx context.Context, cf *chaincode.CommonFactory) error {
+	approvals, err := d.approvals(ctx, cf)
+	if err != nil {
+		return d.fail(DEPLOY_STEP_APPROVE, d.req.ChannelId, err)
+	}
+	for _, org := range d.orgs {
+		approved, err := d.approveForOrg(ctx, org, approvals[org.mspID])
+		if err != nil {
+			return d.fail(DEPLOY_STEP_APPROVE, org.mspID, err)
+		}
+		if approved {
+			d.skip(DEPLOY_STEP_APPROVE, org.mspID, "definition is already approved")
+			continue
+		}
+		approvals[org.mspID] = false
+		d.resp.Steps = append(d.resp.Steps, &protoutil.DeployStep{Name: DEPLOY_STEP_APPROVE, Target: org.mspID, Status: RESPONSE_OK})
+	}
+
+	timeout := deployApproveTimeout
+	if d.req.ApproveTimeout > 0 {
+		timeout = time.Duration(d.req.ApproveTimeout) * time.Second
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		var pending []string
+		for _, org := range d.orgs {
+			if !approvals[org.mspID] {
+				pending = append(pending, org.mspID)
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return d.fail(DEPLOY_STEP_APPROVE, d.req.ChannelId, errors.Errorf("approvals of %s are not committed in %s", strings.Join(pending, ", "), timeout))
+		}
+		select {
+		case <-ctx.Done():
+			return d.fail(DEPLOY_STEP_APPROVE, d.req.ChannelId, ctx.Err())
+		case <-time.After(deployPollInterval):
+		}
+		if approvals, err = d.approvals(ctx, cf); err != nil {
+			return d.fail(DEPLOY_STEP_APPROVE, d.req.ChannelId, err)
+		}
+	}
+}
+
+// approveForOrg 组织授信链码定义；组织已授信该定义且使用同一个链码包时跳过，返回true
+func (d *deployment) approveForOrg(ctx context.Context, org *deployOrg, approved bool) (bool, error) {
+	cf, err := createCommonFactory(ctx, org.peers[0], []*protoutil.Peer{org.peers[0]}, d.req.Orderer)
+	if err != nil {
+		return false, err
+	}
+	defer cf.Close()
+	if approved {
+		// 链码包不属于链码定义，授信的包须从组织自己的节点查询
+		result, err := chaincode.QueryApproved(ctx, org.signer, cf.Committer, d.definition.Name, d.req.ChannelId)
+		if err != nil {
+			return false, errors.WithMessage(err, "query approved definition")
+		}
+		if result.Sequence == d.definition.Sequence && result.GetSource().GetLocalPackage().GetPackageId() == d.packageID {
+			return true, nil
+		}
+	}
+	_, err = chaincode.Approve(ctx, org.signer, cf, &chaincode.ApproveChaincodeRequest{
+		PackageID:           d.packageID,
+		Name:                d.definition.Name,
+		Version:             d.definition.Version,
+		Sequence:            d.definition.Sequence,
+		SignPolicy:          d.definition.SignPolicy,
+		ChannelConfigPolicy: d.definition.ChannelConfigPolicy,
+		EndorserPlugin:      d.definition.EndorsementPlugin,
+		ValidationPlugin:    d.definition.ValidationPlugin,
+		CollectionConfig:    d.definition.CollectionConfig,
+		InitRequired:        d.definition.InitRequired,
+	}, d.req.ChannelId)
+	return false, err
+}
+
+// approvals 查询各组织对链码定义的授信情况
+func (d *deployment) approvals(ctx context.Context, cf *chaincode.CommonFactory) (map[string]bool, error) {
+	opts := []chaincode.Option{
+		chaincode.WithName(d.definition.Name),
+		chaincode.WithVersion(d.definition.Version),
+		chaincode.WithSequence(d.definition.Sequence),
+		chaincode.WithEndorsePlugin(d.definition.EndorsementPlugin),
+		chaincode.WithValidatePlugin(d.definition.ValidationPlugin),
+		chaincode.WithSignPolicy(d.definition.SignPolicy),
+		chaincode.WithChannelPolicy(d.definition.ChannelConfigPolicy),
+		chaincode.WithCollectionConfig(d.definition.CollectionConfig),
+	}
+	if d.definition.InitRequired {
+		opts = append(opts, chaincode.WithInitRequired())
+	}
+	result, err := chaincode.CheckCommitReadiness(ctx, d.orgs[0].signer, cf.Committer, d.req.ChannelId, opts...)
+	if err != nil {
+		return nil, errors.WithMessage(err, "check commit readiness")
+	}
+	approvals := make(map[string]bool, len(result.Approvals))
+	for mspID, approved := range result.Approvals {
+		approvals[mspID] = approved
+	}
+	return approvals, nil
+}
+
+func (d *deployment) commit(ctx context.Context, cf *chaincode.CommonFactory) error {
+	definition := *d.definition
+	definition.WaitForEventTimeout = deployCommitTimeout
+	resp, err := chaincode.Commit(ctx, d.orgs[0].signer, cf, &definition, d.req.ChannelId)
+	if err != nil {
+		return d.fail(DEPLOY_STEP_COMMIT, d.req.ChannelId, err)
+	}
+	d.resp.Steps = append(d.resp.Steps, &protoutil.DeployStep{Name: DEPLOY_STEP_COMMIT, Target: d.req.ChannelId, Status: resp.Response.Status, Message: resp.TxID})
+	return nil
+}
+
+// init 调用链码的Init，链码已初始化时跳过
+func (d *deployment) init(ctx context.Context, cf *chaincode.CommonFactory) {
+	spec := chaincode.ChaincodeSpec{
+		Name:    d.definition.Name,
+		Version: d.definition.Version,
+		Args:    d.req.InitArgs,
+		IsInit:  true,
+		Timeout: deployCommitTimeout,
+	}
+	if d.req.Chaincode.Chaincode != nil {
+		spec.Lang = d.req.Chaincode.Chaincode.Lang
+	}
+	resp, err := chaincode.Invoke(ctx, d.orgs[0].signer, cf, spec, d.req.ChannelId)
+	if err != nil {
+		if errors.Is(err, chaincode.ErrAlreadyInitialized) {
+			d.skip(DEPLOY_STEP_INIT, d.req.ChannelId, err.Error())
+			return
+		}
+		d.fail(DEPLOY_STEP_INIT, d.req.ChannelId, err)
+		return
+	}
+	d.resp.Steps = append(d.resp.Steps, &protoutil.DeployStep{Name: DEPLOY_STEP_INIT, Target: d.req.ChannelId, Status: resp.Response.Status, Message: resp.TxID})
+}
+
+func (d *deployment) skip(step, target, message string) {
+	d.resp.Steps = append(d.resp.Steps, &protoutil.DeployStep{Name: step, Target: target, Status: RESPONSE_OK, Message: message, Skipped: true})
+}
+
+// fail 记录失败的步骤，部署在该步骤终止
+func (d *deployment) fail(step, target string, err error) error {
+	s := &protoutil.DeployStep{Name: step, Target: target, Status: RESPONSE_FAIL, Message: err.Error()}
+	s.ErrorCode, s.ErrorDetails = ErrorDetails(err)
+	d.resp.Steps = append(d.resp.Steps, s)
+	d.resp.Status = RESPONSE_FAIL
+	d.resp.Message = fmt.Sprintf("%s on %s failed: %s", step, target, err)
+	return err
+}
@@ -0,0 +1,50 @@
+package gateway
+
+import (
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
+)
+
+// ErrorDetails returns the error code of err and the details of it, one detail
+// for every failed peer of an endorsement failure
+func ErrorDetails(err error) (protoutil.ErrorCode, []*protoutil.ErrorDetail) {
+	code := protoutil.ErrorCode(sdkerrors.CodeOf(err))
+	e, ok := sdkerrors.As(err)
+	if !ok {
+		return code, nil
+	}
+	if len(e.Errors) == 0 {
+		return code, []*protoutil.ErrorDetail{errorDetail(e)}
+	}
+	details := make([]*protoutil.ErrorDetail, len(e.Errors))
+	for i, pe := range e.Errors {
+		details[i] = errorDetail(pe)
+	}
+	return code, details
+}
+
+func errorDetail(e *sdkerrors.Error) *protoutil.ErrorDetail {
+	return &protoutil.ErrorDetail{
+		Code:           protoutil.ErrorCode(e.Code),
+		Message:        e.Error(),
+		Endpoint:       e.Endpoint,
+		Status:         e.Status,
+		TxId:           e.TxID,
+		ValidationCode: int32(e.ValidationCode),
+	}
+}
+
+// failResponse reports err in a failed response
+func failResponse(err error) *protoutil.Response {
+	code, details := ErrorDetails(err)
+	return &protoutil.Response{Status: RESPONSE_FAIL, Message: err.Error(), ErrorCode: code, ErrorDetails: details}
+}
+
+// errorResponse reports an error of the fabric network in a failed response,
+// other errors are returned as is
+func errorResponse(err error) (*protoutil.Response, error) {
+	if sdkerrors.Is(err, sdkerrors.Unknown) {
+		return nil, err
+	}
+	return failResponse(err), nil
+}
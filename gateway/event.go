@@ -0,0 +1,206 @@
+package gateway
+
+import (
+	"context"
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/event"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ChaincodeEvents 订阅链码事件，每个事件调用一次 send，直到 ctx 结束、send 返回错误或者与节点的连接断开
+func ChaincodeEvents(ctx context.Context, req *protoutil.ChaincodeEventsRequest, send func(*protoutil.ChaincodeEvent) error) error {
+	signer, err := createSigner(ctx, req.Signer)
+	if err != nil {
+		return err
+	}
+	pClient, err := newPeerClient(req.Peer)
+	if err != nil {
+		return err
+	}
+	defer pClient.Close()
+	deliver, err := pClient.GetDeliverClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	start, err := eventStart(req.Start)
+	if err != nil {
+		return err
+	}
+	filter := event.ChaincodeEventFilter{
+		ChaincodeName:  req.ChaincodeName,
+		EventPattern:   req.EventPattern,
+		IncludeInvalid: req.IncludeInvalid,
+	}
+	events, err := event.SubscribeChaincodeEvents(ctx, signer, deliver, pClient.GetAddress(), pClient.GetCertificate(), req.ChannelId, start, filter)
+	if err != nil {
+		return err
+	}
+	defer events.Close()
+
+	for {
+		e, err := events.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		err = send(&protoutil.ChaincodeEvent{
+			TxId:           e.TxID,
+			BlockNumber:    e.BlockNumber,
+			ValidationCode: int32(e.ValidationCode),
+			ChaincodeName:  e.ChaincodeName,
+			EventName:      e.EventName,
+			Payload:        e.Payload,
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+var (
+	checkpointMutex sync.RWMutex
+	// checkpointDir 为空时检查点只保存在内存中
+	checkpointDir string
+)
+
+// Checkpointer 记录已处理的区块，用于 Blocks
+type Checkpointer = event.Checkpointer
+
+// NewFileCheckpointer 创建保存在 path 的检查点
+func NewFileCheckpointer(path string) Checkpointer {
+	return event.NewFileCheckpointer(path)
+}
+
+// NewMemoryCheckpointer 创建只在内存中记录的检查点，Blocks 重新调用时从 req.Start 开始
+func NewMemoryCheckpointer() Checkpointer {
+	return event.NewMemoryCheckpointer()
+}
+
+// SetCheckpointDir 设置 Blocks 默认保存检查点的目录，dir 解析为绝对路径，未设置或 dir 为空时检查点只保存在内存中
+func SetCheckpointDir(dir string) error {
+	if dir != "" {
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return errors.Wrapf(err, "invalid checkpoint dir %s", dir)
+		}
+		dir = abs
+	}
+	checkpointMutex.Lock()
+	defer checkpointMutex.Unlock()
+	checkpointDir = dir
+	return nil
+}
+
+// defaultCheckpointer 返回检查点目录下的检查点，按签名者的 MSP 和通道分目录保存，
+// 不同组织或通道的订阅使用相同的 req.CheckpointId 时互不影响
+func defaultCheckpointer(req *protoutil.BlocksRequest, mspID string) (Checkpointer, error) {
+	checkpointMutex.RLock()
+	dir := checkpointDir
+	checkpointMutex.RUnlock()
+	if dir == "" {
+		return event.NewMemoryCheckpointer(), nil
+	}
+	id := req.CheckpointId
+	if id == "" {
+		id = event.BlockType(req.Type).String()
+	}
+	for _, name := range []string{mspID, req.ChannelId, id} {
+		if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+			return nil, errors.Errorf("invalid checkpoint name %q", name)
+		}
+	}
+	dir = filepath.Join(dir, mspID, req.ChannelId)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, errors.Wrap(err, "create checkpoint dir")
+	}
+	return event.NewFileCheckpointer(filepath.Join(dir, id+".json")), nil
+}
+
+// Blocks 监听通道上的区块，每个区块调用一次 send。与节点的连接断开时按退避时间重连并在 req.Peers 之间切换，
+// 从检查点继续，并在 send 返回nil后保存检查点。checkpointer 为nil时使用检查点目录下签名者 MSP 和通道的目录中以 req.CheckpointId 命名的文件，
+// 直到 ctx 结束、send 返回错误或者节点拒绝请求时返回
+func Blocks(ctx context.Context, req *protoutil.BlocksRequest, checkpointer Checkpointer, send func(*protoutil.BlockEvent) error) error {
+	signer, err := createSigner(ctx, req.Signer)
+	if err != nil {
+		return err
+	}
+	if checkpointer == nil {
+		if checkpointer, err = defaultCheckpointer(req, signer.GetMSPId()); err != nil {
+			return err
+		}
+	}
+	pClients, err := createPeerClients(req.Peers)
+	if err != nil {
+		return err
+	}
+	defer closePeerClients(pClients)
+	peers := make([]event.DeliverPeer, 0, len(pClients))
+	for _, pClient := range pClients {
+		peers = append(peers, pClient)
+	}
+
+	start, err := eventStart(req.Start)
+	if err != nil {
+		return err
+	}
+	listener, err := event.NewBlockListener(signer, peers, event.ListenerConfig{
+		ChannelID:    req.ChannelId,
+		Type:         event.BlockType(req.Type),
+		Start:        start,
+		Checkpointer: checkpointer,
+	})
+	if err != nil {
+		return err
+	}
+	return listener.Run(ctx, func(block *event.Block) error {
+		e, err := blockEvent(block)
+		if err != nil {
+			return err
+		}
+		return send(e)
+	})
+}
+
+func blockEvent(block *event.Block) (*protoutil.BlockEvent, error) {
+	e := &protoutil.BlockEvent{Number: block.Number, Peer: block.Peer}
+	var err error
+	if block.Block != nil {
+		if e.Block, err = proto.Marshal(block.Block); err != nil {
+			return nil, errors.Wrap(err, "marshal block")
+		}
+	}
+	if block.FilteredBlock != nil {
+		if e.FilteredBlock, err = proto.Marshal(block.FilteredBlock); err != nil {
+			return nil, errors.Wrap(err, "marshal filtered block")
+		}
+	}
+	if len(block.PrivateData) > 0 {
+		e.PrivateData = make(map[uint64][]byte, len(block.PrivateData))
+		for seq, pvtData := range block.PrivateData {
+			if e.PrivateData[seq], err = proto.Marshal(pvtData); err != nil {
+				return nil, errors.Wrapf(err, "marshal private data of transaction %d", seq)
+			}
+		}
+	}
+	return e, nil
+}
+
+func eventStart(start *protoutil.EventStart) (event.Start, error) {
+	switch start.GetType() {
+	case protoutil.EventStart_NEWEST:
+		return event.Start{Type: event.StartNewest}, nil
+	case protoutil.EventStart_OLDEST:
+		return event.Start{Type: event.StartOldest}, nil
+	case protoutil.EventStart_BLOCK:
+		return event.Start{Type: event.StartBlock, BlockNumber: start.BlockNumber}, nil
+	}
+	return event.Start{}, errors.Errorf("unsupported event start type %v", start.GetType())
+}
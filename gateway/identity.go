@@ -0,0 +1,130 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/godzilla-s/fabricsdk-go/wallet"
+	"github.com/pkg/errors"
+	"sync"
+)
+
+// ErrIdentityDenied is returned when the caller of a request is not allowed to
+// sign with the identity of the label it refers to
+var ErrIdentityDenied = errors.New("identity label is not allowed for the caller")
+
+var (
+	walletMutex sync.RWMutex
+	idWallet    wallet.Wallet
+	// walletAccess restricts the remote callers of every label, remote callers
+	// cannot sign by label if it is nil
+	walletAccess wallet.AccessList
+	// signers created from the wallet by label, so the key is parsed only once
+	signerCache = make(map[string]*cachedSigner)
+)
+
+type cachedSigner struct {
+	identity *wallet.Identity
+	signer   cryptoutil.Signer
+}
+
+// SetWallet sets the wallet which identities are loaded from when a request
+// refers to its signer by identity label
+func SetWallet(w wallet.Wallet) {
+	walletMutex.Lock()
+	defer walletMutex.Unlock()
+	idWallet = w
+	signerCache = make(map[string]*cachedSigner)
+}
+
+// SetWalletAccess sets the callers allowed to sign with every identity of the
+// wallet. It only restricts requests marked by WithCaller, in-process callers
+// of the gateway hold the wallet themselves
+func SetWalletAccess(access wallet.AccessList) {
+	walletMutex.Lock()
+	defer walletMutex.Unlock()
+	walletAccess = access
+}
+
+type callerKey struct{}
+
+// WithCaller marks ctx as a request of a remote caller authenticated as caller,
+// caller is empty if the request is not authenticated
+func WithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// checkWalletAccess checks whether the caller of ctx may sign as label
+func checkWalletAccess(ctx context.Context, label string) error {
+	caller, remote := ctx.Value(callerKey{}).(string)
+	if !remote {
+		return nil
+	}
+	walletMutex.RLock()
+	access := walletAccess
+	walletMutex.RUnlock()
+	if !access.Allowed(label, caller) {
+		if caller == "" {
+			return errors.WithMessagef(ErrIdentityDenied, "unauthenticated caller signing as %s", label)
+		}
+		return errors.WithMessagef(ErrIdentityDenied, "caller %s signing as %s", caller, label)
+	}
+	return nil
+}
+
+func createSigner(ctx context.Context, signer *protoutil.Signer) (cryptoutil.Signer, error) {
+	if signer == nil {
+		return nil, errors.New("signer is required")
+	}
+	if signer.IdentityLabel != "" {
+		if err := checkWalletAccess(ctx, signer.IdentityLabel); err != nil {
+			return nil, err
+		}
+		return getWalletSigner(signer.IdentityLabel)
+	}
+	return newSigner(signer.MspId, signer.Cert, signer.Key)
+}
+
+func newSigner(mspID string, cert, key []byte) (cryptoutil.Signer, error) {
+	cs, err := cryptoutil.GetMyCryptoSuiteFromBytes(key, cert, mspID)
+	if err != nil {
+		return nil, err
+	}
+	return cs.NewSigner()
+}
+
+func getWalletSigner(label string) (cryptoutil.Signer, error) {
+	walletMutex.RLock()
+	w := idWallet
+	cached := signerCache[label]
+	walletMutex.RUnlock()
+	if w == nil {
+		return nil, errors.Errorf("no wallet to load identity %s from", label)
+	}
+
+	id, err := w.Get(label)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "load identity %s", label)
+	}
+	// the identity may have been replaced in the wallet since it was cached
+	if cached != nil && sameIdentity(cached.identity, id) {
+		return cached.signer, nil
+	}
+
+	signer, err := newSigner(id.MspID, id.Cert, id.Key)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "create signer of identity %s", label)
+	}
+
+	walletMutex.Lock()
+	if idWallet == w {
+		signerCache[label] = &cachedSigner{identity: id, signer: signer}
+	}
+	walletMutex.Unlock()
+	return signer, nil
+}
+
+func sameIdentity(a, b *wallet.Identity) bool {
+	return a.MspID == b.MspID && bytes.Equal(a.Cert, b.Cert) && bytes.Equal(a.Key, b.Key)
+}
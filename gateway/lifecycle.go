@@ -0,0 +1,264 @@
+package gateway
+
+import (
+	"context"
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/chaincode"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/pkg/errors"
+	"sort"
+)
+
+// ChaincodeQueryInstalled 查询节点上安装的链码
+func ChaincodeQueryInstalled(ctx context.Context, req *protoutil.ChaincodeQueryInstalledRequest) (*protoutil.ChaincodeQueryInstalledResponse, error) {
+	signer, endorser, closer, err := lifecycleEndorser(ctx, req.Signer, req.Peer)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	list, err := chaincode.QueryInstalled(ctx, signer, endorser)
+	if err != nil {
+		return nil, err
+	}
+	resp := &protoutil.ChaincodeQueryInstalledResponse{}
+	for _, cc := range list.InstalledChaincodes {
+		installed := &protoutil.InstalledChaincode{PackageId: cc.PackageId, Label: cc.Label}
+		channelIDs := make([]string, 0, len(cc.References))
+		for channelID := range cc.References {
+			channelIDs = append(channelIDs, channelID)
+		}
+		sort.Strings(channelIDs)
+		for _, channelID := range channelIDs {
+			for _, ref := range cc.References[channelID].Chaincodes {
+				installed.References = append(installed.References, &protoutil.InstalledChaincode_Reference{
+					ChannelId: channelID,
+					Name:      ref.Name,
+					Version:   ref.Version,
+				})
+			}
+		}
+		resp.Chaincodes = append(resp.Chaincodes, installed)
+	}
+	return resp, nil
+}
+
+// ChaincodeGetInstalledPackage 从节点下载已安装的链码包
+func ChaincodeGetInstalledPackage(ctx context.Context, req *protoutil.ChaincodeGetPackageRequest) (*protoutil.ChaincodeGetPackageResponse, error) {
+	signer, endorser, closer, err := lifecycleEndorser(ctx, req.Signer, req.Peer)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	pkgBytes, err := chaincode.GetInstalledPackage(ctx, signer, endorser, req.PackageId)
+	if err != nil {
+		return nil, err
+	}
+	return &protoutil.ChaincodeGetPackageResponse{PkgBytes: pkgBytes}, nil
+}
+
+// ChaincodeQueryApproved 查询组织授信的链码定义
+func ChaincodeQueryApproved(ctx context.Context, req *protoutil.ChaincodeQueryApprovedRequest) (*protoutil.ChaincodeQueryApprovedResponse, error) {
+	signer, endorser, closer, err := lifecycleEndorser(ctx, req.Signer, req.Peer)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	result, err := chaincode.QueryApproved(ctx, signer, endorser, req.Name, req.ChannelId, chaincode.WithSequence(req.Sequence))
+	if err != nil {
+		return nil, err
+	}
+	return approvedResponse(req.Name, result)
+}
+
+// ChaincodeQueryCommitted 查询通道上已提交的链码定义，未指定链码名称时查询所有链码
+func ChaincodeQueryCommitted(ctx context.Context, req *protoutil.ChaincodeQueryCommittedRequest) (*protoutil.ChaincodeQueryCommittedResponse, error) {
+	signer, endorser, closer, err := lifecycleEndorser(ctx, req.Signer, req.Peer)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	return queryCommitted(ctx, signer, endorser, req.ChannelId, req.Name)
+}
+
+// ChaincodeCheckCommitReadiness 检查链码定义是否可以提交，返回各组织的授信情况
+func ChaincodeCheckCommitReadiness(ctx context.Context, req *protoutil.ChaincodeCheckReadinessRequest) (*protoutil.ChaincodeCheckReadinessResponse, error) {
+	if req.Definition == nil {
+		return nil, errors.New("chaincode definition is required")
+	}
+	signer, endorser, closer, err := lifecycleEndorser(ctx, req.Signer, req.Peer)
+	if err != nil {
+		return nil, err
+	}
+	defer closer()
+
+	opts := []chaincode.Option{
+		chaincode.WithName(req.Definition.Name),
+		chaincode.WithVersion(req.Definition.Version),
+		chaincode.WithSequence(req.Definition.Sequence),
+		chaincode.WithEndorsePlugin(req.Definition.EndorsePlugin),
+		chaincode.WithValidatePlugin(req.Definition.ValidatePlugin),
+		chaincode.WithSignPolicy(req.SignaturePolicy),
+		chaincode.WithChannelPolicy(req.ChannelConfigPolicy),
+		chaincode.WithCollectionConfig(req.CollectionConfig),
+	}
+	if req.Definition.InitRequired {
+		opts = append(opts, chaincode.WithInitRequired())
+	}
+	result, err := chaincode.CheckCommitReadiness(ctx, signer, endorser, req.ChannelId, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &protoutil.ChaincodeCheckReadinessResponse{Approvals: result.Approvals}, nil
+}
+
+// ChaincodeLifecycleStatus 汇总链码已提交的定义和各组织最新授信的定义
+func ChaincodeLifecycleStatus(ctx context.Context, req *protoutil.ChaincodeLifecycleStatusRequest) (*protoutil.ChaincodeLifecycleStatusResponse, error) {
+	if len(req.Orgs) == 0 {
+		return nil, errors.New("at least one organization is required")
+	}
+	if req.Name == "" {
+		return nil, errors.New("chaincode name is required")
+	}
+	resp := &protoutil.ChaincodeLifecycleStatusResponse{}
+	for i, org := range req.Orgs {
+		if len(org.Peers) == 0 {
+			return nil, errors.Errorf("organization %d has no peers", i)
+		}
+		signer, endorser, closer, err := lifecycleEndorser(ctx, org.Signer, org.Peers[0])
+		if err != nil {
+			return nil, errors.WithMessagef(err, "organization %d", i)
+		}
+		if i == 0 {
+			committed, err := queryCommitted(ctx, signer, endorser, req.ChannelId, req.Name)
+			if err != nil && !errors.Is(err, chaincode.ErrNotDefined) {
+				closer()
+				return nil, errors.WithMessage(err, "query committed definition")
+			}
+			if err == nil && len(committed.Definitions) > 0 {
+				resp.Committed = committed.Definitions[0]
+			}
+		}
+
+		status := &protoutil.OrgApproval{MspId: signer.GetMSPId()}
+		if result, err := chaincode.QueryApproved(ctx, signer, endorser, req.Name, req.ChannelId); err != nil {
+			status.Message = err.Error()
+		} else if approved, err := approvedResponse(req.Name, result); err != nil {
+			status.Message = err.Error()
+		} else {
+			status.Approved, status.PackageId = approved.Definition, approved.PackageId
+		}
+		status.ApprovedCommitted = resp.Committed.GetApprovals()[status.MspId]
+		resp.Orgs = append(resp.Orgs, status)
+		closer()
+	}
+	return resp, nil
+}
+
+// lifecycleEndorser 创建查询 _lifecycle 的签名者和节点，用完须调用返回的closer
+func lifecycleEndorser(ctx context.Context, s *protoutil.Signer, p *protoutil.Peer) (cryptoutil.Signer, peer.EndorserClient, func(), error) {
+	if p == nil {
+		return nil, nil, nil, errors.New("peer is required")
+	}
+	signer, err := createSigner(ctx, s)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	pClient, err := newPeerClient(p)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	endorser, err := pClient.GetEndorser(ctx)
+	if err != nil {
+		pClient.Close()
+		return nil, nil, nil, err
+	}
+	return signer, endorser, func() { pClient.Close() }, nil
+}
+
+func queryCommitted(ctx context.Context, signer cryptoutil.Signer, endorser peer.EndorserClient, channelID, name string) (*protoutil.ChaincodeQueryCommittedResponse, error) {
+	var opts []chaincode.Option
+	if name != "" {
+		opts = append(opts, chaincode.WithName(name))
+	}
+	list, err := chaincode.QueryCommitted(ctx, signer, endorser, channelID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &protoutil.ChaincodeQueryCommittedResponse{}
+	if name != "" {
+		def := &list.QueryChaincodeDefinitionResult
+		committed, err := committedDefinition(&protoutil.DefinitionArgs{
+			Name:           name,
+			Version:        def.Version,
+			Sequence:       def.Sequence,
+			EndorsePlugin:  def.EndorsementPlugin,
+			ValidatePlugin: def.ValidationPlugin,
+			ValidateParams: def.ValidationParameter,
+			InitRequired:   def.InitRequired,
+		}, def.Collections)
+		if err != nil {
+			return nil, err
+		}
+		committed.Approvals = def.Approvals
+		resp.Definitions = append(resp.Definitions, committed)
+		return resp, nil
+	}
+	for _, def := range list.ChaincodeDefinitions {
+		committed, err := committedDefinition(&protoutil.DefinitionArgs{
+			Name:           def.Name,
+			Version:        def.Version,
+			Sequence:       def.Sequence,
+			EndorsePlugin:  def.EndorsementPlugin,
+			ValidatePlugin: def.ValidationPlugin,
+			ValidateParams: def.ValidationParameter,
+			InitRequired:   def.InitRequired,
+		}, def.Collections)
+		if err != nil {
+			return nil, err
+		}
+		resp.Definitions = append(resp.Definitions, committed)
+	}
+	return resp, nil
+}
+
+func committedDefinition(definition *protoutil.DefinitionArgs, collections *peer.CollectionConfigPackage) (*protoutil.CommittedDefinition, error) {
+	collectionBytes, err := marshalCollections(collections)
+	if err != nil {
+		return nil, err
+	}
+	return &protoutil.CommittedDefinition{Definition: definition, Collections: collectionBytes}, nil
+}
+
+func approvedResponse(name string, result *chaincode.ApprovedChaincodeList) (*protoutil.ChaincodeQueryApprovedResponse, error) {
+	collections, err := marshalCollections(result.Collections)
+	if err != nil {
+		return nil, err
+	}
+	return &protoutil.ChaincodeQueryApprovedResponse{
+		Definition: &protoutil.DefinitionArgs{
+			Name:           name,
+			Version:        result.Version,
+			Sequence:       result.Sequence,
+			EndorsePlugin:  result.EndorsementPlugin,
+			ValidatePlugin: result.ValidationPlugin,
+			ValidateParams: result.ValidationParameter,
+			InitRequired:   result.InitRequired,
+		},
+		PackageId:   result.GetSource().GetLocalPackage().GetPackageId(),
+		Collections: collections,
+	}, nil
+}
+
+func marshalCollections(collections *peer.CollectionConfigPackage) ([]byte, error) {
+	if len(collections.GetConfig()) == 0 {
+		return nil, nil
+	}
+	return proto.Marshal(collections)
+}
@@ -0,0 +1,138 @@
+package gateway
+
+import (
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+	"github.com/godzilla-s/fabricsdk-go/network"
+	"github.com/pkg/errors"
+	"sync"
+)
+
+var (
+	networkMutex  sync.RWMutex
+	fabricNetwork *network.Network
+)
+
+// SetNetwork sets the network loaded from a connection profile, so that
+// requests can refer to peers and orderers by name or by organization
+func SetNetwork(n *network.Network) {
+	networkMutex.Lock()
+	defer networkMutex.Unlock()
+	fabricNetwork = n
+}
+
+func getNetwork() (*network.Network, error) {
+	networkMutex.RLock()
+	defer networkMutex.RUnlock()
+	if fabricNetwork == nil {
+		return nil, errors.New("no network configured to resolve peers and orderers")
+	}
+	return fabricNetwork, nil
+}
+
+func peerFromNetwork(p *network.Peer) *protoutil.Peer {
+	return &protoutil.Peer{Url: p.URL, HostName: p.HostName, TlsRootCert: p.TLSRootCert, Name: p.Name}
+}
+
+func ordererFromNetwork(o *network.Orderer) *protoutil.Orderer {
+	return &protoutil.Orderer{Url: o.URL, HostName: o.HostName, TlsRootCert: o.TLSRootCert, Name: o.Name}
+}
+
+// resolvePeers expands the peers of a request, a peer given by organization
+// stands for all peers of the organization
+func resolvePeers(peers []*protoutil.Peer) ([]*protoutil.Peer, error) {
+	resolved := make([]*protoutil.Peer, 0, len(peers))
+	for _, p := range peers {
+		if p == nil {
+			return nil, errors.New("peer is nil")
+		}
+		if p.Url != "" || p.Name != "" || p.Organization == "" {
+			rp, err := resolvePeer(p)
+			if err != nil {
+				return nil, err
+			}
+			resolved = append(resolved, rp)
+			continue
+		}
+		n, err := getNetwork()
+		if err != nil {
+			return nil, err
+		}
+		orgPeers, err := n.PeersOfOrganization(p.Organization)
+		if err != nil {
+			return nil, err
+		}
+		if len(orgPeers) == 0 {
+			return nil, errors.Errorf("organization %s has no peer", p.Organization)
+		}
+		for _, op := range orgPeers {
+			resolved = append(resolved, peerFromNetwork(op))
+		}
+	}
+	return resolved, nil
+}
+
+// resolvePeer returns the peer with its url and TLS settings, which are looked
+// up in the network when the peer is given by name or organization. For an
+// organization the first peer of it is picked
+func resolvePeer(p *protoutil.Peer) (*protoutil.Peer, error) {
+	if p == nil {
+		return nil, errors.New("peer is required")
+	}
+	if p.Url != "" {
+		return p, nil
+	}
+	if p.Name == "" && p.Organization == "" {
+		return nil, errors.New("peer url, name or organization is required")
+	}
+	n, err := getNetwork()
+	if err != nil {
+		return nil, err
+	}
+	if p.Name != "" {
+		np, err := n.Peer(p.Name)
+		if err != nil {
+			return nil, err
+		}
+		return peerFromNetwork(np), nil
+	}
+	orgPeers, err := n.PeersOfOrganization(p.Organization)
+	if err != nil {
+		return nil, err
+	}
+	if len(orgPeers) == 0 {
+		return nil, errors.Errorf("organization %s has no peer", p.Organization)
+	}
+	return peerFromNetwork(orgPeers[0]), nil
+}
+
+// resolveOrderer is the same as resolvePeer for orderers
+func resolveOrderer(o *protoutil.Orderer) (*protoutil.Orderer, error) {
+	if o == nil {
+		return nil, errors.New("orderer is required")
+	}
+	if o.Url != "" {
+		return o, nil
+	}
+	if o.Name == "" && o.Organization == "" {
+		return nil, errors.New("orderer url, name or organization is required")
+	}
+	n, err := getNetwork()
+	if err != nil {
+		return nil, err
+	}
+	if o.Name != "" {
+		no, err := n.Orderer(o.Name)
+		if err != nil {
+			return nil, err
+		}
+		return ordererFromNetwork(no), nil
+	}
+	orgOrderers, err := n.OrderersOfOrganization(o.Organization)
+	if err != nil {
+		return nil, err
+	}
+	if len(orgOrderers) == 0 {
+		return nil, errors.Errorf("organization %s has no orderer", o.Organization)
+	}
+	return ordererFromNetwork(orgOrderers[0]), nil
+}
@@ -6,23 +6,26 @@ import (
 	"fmt"
 	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
 	"github.com/godzilla-s/fabricsdk-go/internal/channel"
-	"github.com/godzilla-s/fabricsdk-go/internal/client/orderer"
 	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
 	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	"strings"
 )
 
 // ProposalInitiate 初始提案，如组织加入通道，加入联盟，组织被通道或者联盟删除
 func ProposalInitiate(ctx context.Context, req *protoutil.ProposalInitRequest) (*protoutil.ProposalEnvelope, error) {
-	signer, err := createSigner(req.Signer)
+	signer, err := createSigner(ctx, req.Signer)
 	if err != nil {
 		return nil, err
 	}
 
-	ordererClient, err := orderer.New(req.Orderer.Url, req.Orderer.HostName, req.Orderer.TlsRootCert)
+	ordererClient, err := newOrdererClient(req.Orderer)
 	if err != nil {
 		return nil, err
 	}
-	lastConfigBlock, err := channel.FetchConfig(signer, ordererClient, req.ChannelId)
+	defer ordererClient.Close()
+	lastConfigBlock, err := channel.FetchConfig(ctx, signer, ordererClient, req.ChannelId)
 	if err != nil {
 		return nil, err
 	}
@@ -53,69 +56,161 @@ func ProposalInitiate(ctx context.Context, req *protoutil.ProposalInitRequest) (
 			return nil, fmt.Errorf("missing proposal content when type is %v", req.Proposal.Type.String())
 		}
 		updateEnvelope, err = channel.ConsortiumRemoveOrg(lastConfigBlock, removedOrgName, req.ConsortiumName, req.ChannelId)
+	case protoutil.ProposalType_Channel_ConfigUpdate, protoutil.ProposalType_Consortium_ConfigUpdate:
+		configUpdate := req.Proposal.GetConfigUpdate()
+		if configUpdate == nil {
+			return nil, fmt.Errorf("missing proposal content when type is %v", req.Proposal.Type.String())
+		}
+		mod, err := createConfigModification(configUpdate)
+		if err != nil {
+			return nil, err
+		}
+		if req.Proposal.Type == protoutil.ProposalType_Channel_ConfigUpdate {
+			updateEnvelope, err = channel.ChannelConfigUpdate(lastConfigBlock, mod, req.ChannelId)
+		} else {
+			updateEnvelope, err = channel.ConsortiumConfigUpdate(lastConfigBlock, mod, req.ConsortiumName, req.ChannelId)
+		}
+		if err != nil {
+			return nil, err
+		}
+	case protoutil.ProposalType_Channel_SetAnchorPeers, protoutil.ProposalType_Channel_AddAnchorPeers, protoutil.ProposalType_Channel_RemoveAnchorPeers:
+		anchorPeers := req.Proposal.GetAnchorPeers()
+		if anchorPeers == nil || anchorPeers.OrgName == "" {
+			return nil, fmt.Errorf("missing proposal content when type is %v", req.Proposal.Type.String())
+		}
+		action := channel.SetAnchorPeers
+		switch req.Proposal.Type {
+		case protoutil.ProposalType_Channel_AddAnchorPeers:
+			action = channel.AddAnchorPeers
+		case protoutil.ProposalType_Channel_RemoveAnchorPeers:
+			action = channel.RemoveAnchorPeers
+		}
+		updateEnvelope, err = channel.AnchorPeersUpdate(lastConfigBlock, anchorPeers.OrgName, action, createAnchorPeers(anchorPeers.AnchorPeers), req.ChannelId)
+	case protoutil.ProposalType_Orderer_AddConsenter, protoutil.ProposalType_Orderer_RemoveConsenter, protoutil.ProposalType_Orderer_RotateConsenterCerts:
+		c := req.Proposal.GetConsenter()
+		if c == nil {
+			return nil, fmt.Errorf("missing proposal content when type is %v", req.Proposal.Type.String())
+		}
+		consenter, err := createConsenter(c, req.Proposal.Type != protoutil.ProposalType_Orderer_RemoveConsenter)
+		if err != nil {
+			return nil, err
+		}
+		switch req.Proposal.Type {
+		case protoutil.ProposalType_Orderer_AddConsenter:
+			updateEnvelope, err = channel.AddConsenter(lastConfigBlock, consenter, req.ChannelId)
+		case protoutil.ProposalType_Orderer_RemoveConsenter:
+			updateEnvelope, err = channel.RemoveConsenter(lastConfigBlock, consenter.Host, consenter.Port, req.ChannelId)
+		default:
+			updateEnvelope, err = channel.RotateConsenterCerts(lastConfigBlock, consenter, req.ChannelId)
+		}
+		if err != nil {
+			return nil, err
+		}
+	case protoutil.ProposalType_Orderer_UpdateRaftOptions:
+		options := req.Proposal.GetRaftOptions()
+		if options == nil {
+			return nil, fmt.Errorf("missing proposal content when type is %v", req.Proposal.Type.String())
+		}
+		updateEnvelope, err = channel.UpdateRaftOptions(lastConfigBlock, createRaftOptions(options), req.ChannelId)
+	case protoutil.ProposalType_Orderer_SetMaintenanceMode:
+		updateEnvelope, err = channel.SetMaintenanceMode(lastConfigBlock, req.Proposal.GetMaintenance(), req.ChannelId)
+	case protoutil.ProposalType_Orderer_AddOrg:
+		ordererOrg := req.Proposal.GetOrdererOrg()
+		if ordererOrg == nil || ordererOrg.Org == nil {
+			return nil, fmt.Errorf("missing proposal content when type is %v", req.Proposal.Type.String())
+		}
+		newOrg := createChannelOrg(ordererOrg.Org)
+		for _, c := range ordererOrg.Consenters {
+			consenter, err := createConsenter(c, true)
+			if err != nil {
+				return nil, err
+			}
+			newOrg.OrdererConsenters = append(newOrg.OrdererConsenters, consenter)
+		}
+		updateEnvelope, err = channel.OrdererAddOrg(lastConfigBlock, newOrg, ordererOrg.UpdateConsenters, req.ChannelId)
+	case protoutil.ProposalType_Orderer_RemoveOrg:
+		ordererOrg := req.Proposal.GetOrdererOrg()
+		if ordererOrg == nil || ordererOrg.OrgName == "" {
+			return nil, fmt.Errorf("missing proposal content when type is %v", req.Proposal.Type.String())
+		}
+		updateEnvelope, err = channel.OrdererRemoveOrg(lastConfigBlock, ordererOrg.OrgName, ordererOrg.UpdateConsenters, req.ChannelId)
 	}
 	if err != nil {
 		return nil, err
 	}
+	if updateEnvelope == nil {
+		return nil, fmt.Errorf("unsupported proposal type %v", req.Proposal.Type.String())
+	}
+	config, err := readLastConfig(lastConfigBlock, req.Proposal)
+	if err != nil {
+		return nil, err
+	}
 	proposal := updateEnvelope.GetUpdates()
-	sig, err := channel.SignUpdateConfig(signer, proposal)
+	sign, err := signProposal(signer, proposal)
 	if err != nil {
 		return nil, err
 	}
-	sigBytes, err := proto.Marshal(sig)
+	record, err := newProposalRecord(config, req, proposal, sign)
 	if err != nil {
 		return nil, err
 	}
-	proposalHash, err := cryptoutil.Hash(proposal, cryptoutil.SHA2_256)
-	envelope := &protoutil.ProposalEnvelope{
-		ChannelId: req.ChannelId,
-		Proposal: proposal,
-		Sign: &protoutil.ProposalSignature{
-			ProposalHash: proposalHash,
-			Creator: signer.GetMSPId(),
-			Signature: sigBytes,
-		},
+	if err := getProposalStore().Create(record); err != nil {
+		return nil, err
 	}
-
-	return envelope, nil
+	return proposalEnvelope(record), nil
 }
 
-
-// ProposalSign 提案签名
-func ProposalSign(ctx context.Context, req *protoutil.ProposalSignRequest) (*protoutil.ProposalSignature, error) {
-	signer, err := createSigner(req.Signer)
+// signProposal 签名提案
+func signProposal(signer cryptoutil.Signer, proposal []byte) (*protoutil.ProposalSignature, error) {
+	sig, err := channel.SignUpdateConfig(signer, proposal)
 	if err != nil {
 		return nil, err
 	}
-
-	sig, err := channel.SignUpdateConfig(signer, req.Envelope.Proposal)
+	sigBytes, err := proto.Marshal(sig)
 	if err != nil {
 		return nil, err
 	}
-	sigBytes, err := proto.Marshal(sig)
+	proposalHash, err := cryptoutil.Hash(proposal, cryptoutil.SHA2_256)
 	if err != nil {
 		return nil, err
 	}
-	proposalHash, _ := cryptoutil.Hash(req.Envelope.Proposal, cryptoutil.SHA2_256)
-	proposalSig := &protoutil.ProposalSignature{
+	return &protoutil.ProposalSignature{
 		ProposalHash: proposalHash,
-		Creator: signer.GetMSPId(),
-		Signature: sigBytes,
+		Creator:      signer.GetMSPId(),
+		Signature:    sigBytes,
+	}, nil
+}
+
+
+// ProposalSign 提案签名
+func ProposalSign(ctx context.Context, req *protoutil.ProposalSignRequest) (*protoutil.ProposalSignature, error) {
+	signer, err := createSigner(ctx, req.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	// 提案由存储跟踪时，记录签名并检查截止时间
+	if len(req.Envelope.ProposalId) > 0 {
+		return signStoredProposal(signer, string(req.Envelope.ProposalId), req.Envelope.Proposal)
 	}
-	return proposalSig, nil
+	return signProposal(signer, req.Envelope.Proposal)
 }
 
 // ProposalSubmit 提案提交
 func ProposalSubmit(ctx context.Context, req *protoutil.ProposalSubmitRequest) (*protoutil.Response, error) {
-	signer, err := createSigner(req.Signer)
+	signer, err := createSigner(ctx, req.Signer)
 	if err != nil {
 		return nil, err
 	}
 
-	ordererClient, err := orderer.New(req.Orderer.Url, req.Orderer.HostName, req.Orderer.TlsRootCert)
+	ordererClient, err := newOrdererClient(req.Orderer)
 	if err != nil {
 		return nil, err
 	}
+	defer ordererClient.Close()
+	if len(req.Envelope.ProposalId) > 0 {
+		return submitStoredProposal(ctx, signer, ordererClient, string(req.Envelope.ProposalId), req.Sigs)
+	}
 	sponsor := req.Envelope.Sign
 	if sponsor.Creator != signer.GetMSPId() {
 		return nil, fmt.Errorf("proposal submit must be the same with sponsor, submit: %s, sponsor:%s", signer.GetMSPId(), sponsor.Creator)
@@ -129,13 +224,44 @@ func ProposalSubmit(ctx context.Context, req *protoutil.ProposalSubmitRequest) (
 		}
 	}
 
+	lastConfigBlock, err := channel.FetchConfig(ctx, signer, ordererClient, req.Envelope.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeConfigUpdate(lastConfigBlock, req.Envelope.Proposal, sigs); err != nil {
+		return errorResponse(err)
+	}
+
 	updateEnvelope, err := channel.CreateUpdateEnvelope(req.Envelope.Proposal, sigs, req.Envelope.ChannelId)
 	if err != nil {
 		return nil, err
 	}
-	err = channel.Update(signer, updateEnvelope, ordererClient)
+	err = channel.Update(ctx, signer, updateEnvelope, ordererClient)
 	if err != nil {
-		return &protoutil.Response{Status: 500, Message: err.Error()}, nil
+		return failResponse(err), nil
 	}
 	return &protoutil.Response{Status: 200}, nil
+}
+
+// authorizeConfigUpdate verifies the signatures of the config update against
+// the MSPs of the channel config, and checks that they satisfy the mod_policy
+// of every modified config element, so that an update the orderer would
+// reject fails before it is broadcast
+func authorizeConfigUpdate(lastConfigBlock *cb.Block, update []byte, sigs map[string][]byte) error {
+	identities, err := channel.VerifyConfigSignatures(lastConfigBlock, update, sigs)
+	if err != nil {
+		return err
+	}
+	unsatisfied, err := channel.CheckConfigUpdatePolicies(lastConfigBlock, update, identities)
+	if err != nil {
+		return err
+	}
+	if len(unsatisfied) == 0 {
+		return nil
+	}
+	reasons := make([]string, len(unsatisfied))
+	for i, p := range unsatisfied {
+		reasons[i] = p.String()
+	}
+	return sdkerrors.New(sdkerrors.PolicyFailure, "config update is not authorized: %s", strings.Join(reasons, "; "))
 }
\ No newline at end of file
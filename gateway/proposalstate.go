@@ -0,0 +1,409 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"github.com/godzilla-s/fabricsdk-go/gateway/proposalstore"
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/channel"
+	"github.com/godzilla-s/fabricsdk-go/internal/client/orderer"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	"github.com/pkg/errors"
+	"sync"
+	"time"
+)
+
+var (
+	proposalMutex sync.RWMutex
+	proposals     = proposalstore.NewInMemoryStore()
+
+	// commitPollInterval and commitPollTimes bound how long a submitted proposal
+	// is watched for its config block
+	commitPollInterval = 500 * time.Millisecond
+	commitPollTimes    = 10
+)
+
+// SetProposalStore sets the store which tracks the proposals, proposals are
+// kept in memory if it is not set
+func SetProposalStore(s proposalstore.Store) {
+	proposalMutex.Lock()
+	defer proposalMutex.Unlock()
+	proposals = s
+}
+
+func getProposalStore() proposalstore.Store {
+	proposalMutex.RLock()
+	defer proposalMutex.RUnlock()
+	return proposals
+}
+
+// ProposalQuery 查询提案
+func ProposalQuery(ctx context.Context, req *protoutil.ProposalQueryRequest) (*protoutil.ProposalEnvelope, error) {
+	reconcile, done, err := proposalReconciler(ctx, req.Signer, req.Orderer)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	r, err := getProposal(string(req.ProposalId))
+	if err != nil {
+		return nil, err
+	}
+	return proposalEnvelope(reconcile(r)), nil
+}
+
+// ProposalList 提案列表
+func ProposalList(ctx context.Context, req *protoutil.ProposalListRequest) (*protoutil.ProposalListResponse, error) {
+	reconcile, done, err := proposalReconciler(ctx, req.Signer, req.Orderer)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	records, err := getProposalStore().List()
+	if err != nil {
+		return nil, err
+	}
+	resp := &protoutil.ProposalListResponse{}
+	for _, r := range records {
+		if req.ChannelId != "" && r.ChannelID != req.ChannelId {
+			continue
+		}
+		if r.State != proposalstore.Expired && r.Expire(time.Now()) {
+			// store the expiry as well
+			if r, err = getProposal(r.ID); err != nil {
+				return nil, err
+			}
+		}
+		r = reconcile(r)
+		if req.State != protoutil.ProposalState_State_Unknown && int32(r.State) != int32(req.State) {
+			continue
+		}
+		resp.Proposals = append(resp.Proposals, proposalEnvelope(r))
+	}
+	return resp, nil
+}
+
+// proposalReconciler returns a function storing a submitted proposal as
+// committed once the config of its channel is beyond the sequence the proposal
+// was computed against, the proposals are kept as is without signer or orderer
+func proposalReconciler(ctx context.Context, s *protoutil.Signer, o *protoutil.Orderer) (func(*proposalstore.Record) *proposalstore.Record, func(), error) {
+	if s == nil || o == nil {
+		return func(r *proposalstore.Record) *proposalstore.Record { return r }, func() {}, nil
+	}
+	signer, err := createSigner(ctx, s)
+	if err != nil {
+		return nil, nil, err
+	}
+	ordererClient, err := newOrdererClient(o)
+	if err != nil {
+		return nil, nil, err
+	}
+	// the config of a channel is fetched once for all its proposals
+	sequences := make(map[string]uint64)
+	reconcile := func(r *proposalstore.Record) *proposalstore.Record {
+		if r.State != proposalstore.Submitted {
+			return r
+		}
+		sequence, ok := sequences[r.ChannelID]
+		if !ok {
+			block, err := channel.FetchConfig(ctx, signer, ordererClient, r.ChannelID)
+			if err != nil {
+				return r
+			}
+			if sequence, err = channel.ConfigSequence(block); err != nil {
+				return r
+			}
+			sequences[r.ChannelID] = sequence
+		}
+		if sequence <= r.ConfigSequence {
+			return r
+		}
+		committed, err := getProposalStore().Update(r.ID, func(r *proposalstore.Record) error {
+			if r.State == proposalstore.Submitted {
+				r.State = proposalstore.Committed
+				r.Message = ""
+			}
+			return nil
+		})
+		if err != nil {
+			return r
+		}
+		return committed
+	}
+	return reconcile, func() { ordererClient.Close() }, nil
+}
+
+// getProposal returns the proposal of the id, a proposal whose deadline has
+// passed is stored as expired first
+func getProposal(id string) (*proposalstore.Record, error) {
+	store := getProposalStore()
+	r, err := store.Get(id)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "proposal %s", id)
+	}
+	if r.State != proposalstore.Expired && r.Expire(time.Now()) {
+		return store.Update(id, func(r *proposalstore.Record) error {
+			r.Expire(time.Now())
+			return nil
+		})
+	}
+	return r, nil
+}
+
+// openProposal returns the proposal of the id if it can still be signed or submitted
+func openProposal(id string) (*proposalstore.Record, error) {
+	r, err := getProposal(id)
+	if err != nil {
+		return nil, err
+	}
+	return r, checkOpen(r)
+}
+
+func checkOpen(r *proposalstore.Record) error {
+	if r.Expire(time.Now()) {
+		return errors.WithMessagef(proposalstore.ErrExpired, "proposal %s deadline %s", r.ID, r.Deadline.Format(time.RFC3339))
+	}
+	if r.State.Final() || r.State == proposalstore.Submitted {
+		return errors.WithMessagef(proposalstore.ErrClosed, "proposal %s is %s", r.ID, r.State)
+	}
+	return nil
+}
+
+// newProposalRecord creates the record of a proposal initiated against the config block
+func newProposalRecord(config *lastConfig, req *protoutil.ProposalInitRequest, update []byte, sign *protoutil.ProposalSignature) (*proposalstore.Record, error) {
+	blockBytes, err := proto.Marshal(config.block)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal config block")
+	}
+	r := &proposalstore.Record{
+		Type:           int32(req.Proposal.Type),
+		ChannelID:      req.ChannelId,
+		Update:         update,
+		ProposalHash:   sign.ProposalHash,
+		Creator:        sign.Creator,
+		Members:        config.members,
+		ConfigSequence: config.sequence,
+		ConfigBlock:    blockBytes,
+	}
+	if req.Proposal.Deadline > 0 {
+		r.Deadline = time.Unix(req.Proposal.Deadline, 0)
+		if !time.Now().Before(r.Deadline) {
+			return nil, errors.Errorf("proposal deadline %s has passed", r.Deadline.Format(time.RFC3339))
+		}
+	}
+	r.AddSignature(sign.Creator, sign.Signature)
+	r.SetReady(proposalReady(r))
+	return r, nil
+}
+
+// proposalReady checks whether the signatures of the proposal satisfy the
+// mod_policy of every config element its update modifies, in the config the
+// update is computed against
+func proposalReady(r *proposalstore.Record) bool {
+	if len(r.ConfigBlock) == 0 {
+		return false
+	}
+	block := &cb.Block{}
+	if err := proto.Unmarshal(r.ConfigBlock, block); err != nil {
+		return false
+	}
+	return authorizeConfigUpdate(block, r.Update, r.Signatures) == nil
+}
+
+// lastConfig is what a proposal needs to know of the config it is computed against
+type lastConfig struct {
+	block    *cb.Block
+	sequence uint64
+	members  []string
+}
+
+// readLastConfig reads the sequence of the config block and the organizations
+// expected to sign the proposal, the orderer organizations for a consortium or
+// ordering service update, the organization itself for an anchor peers update
+// and the application organizations for other channel updates
+func readLastConfig(block *cb.Block, proposal *protoutil.Proposal) (*lastConfig, error) {
+	sequence, err := channel.ConfigSequence(block)
+	if err != nil {
+		return nil, err
+	}
+	groupKey := channel.ApplicationGroupKey
+	switch proposal.Type {
+	case protoutil.ProposalType_Consortium_AddPeerOrg, protoutil.ProposalType_Consortium_RemovePeerOrg, protoutil.ProposalType_Consortium_ConfigUpdate,
+		protoutil.ProposalType_Orderer_AddConsenter, protoutil.ProposalType_Orderer_RemoveConsenter, protoutil.ProposalType_Orderer_RotateConsenterCerts,
+		protoutil.ProposalType_Orderer_UpdateRaftOptions, protoutil.ProposalType_Orderer_SetMaintenanceMode,
+		protoutil.ProposalType_Orderer_AddOrg, protoutil.ProposalType_Orderer_RemoveOrg:
+		groupKey = channel.OrdererGroupKey
+	case protoutil.ProposalType_Channel_SetAnchorPeers, protoutil.ProposalType_Channel_AddAnchorPeers, protoutil.ProposalType_Channel_RemoveAnchorPeers:
+		mspID, err := channel.OrganizationMSPID(block, channel.ApplicationGroupKey, proposal.GetAnchorPeers().GetOrgName())
+		if err != nil {
+			return nil, err
+		}
+		return &lastConfig{block: block, sequence: sequence, members: []string{mspID}}, nil
+	}
+	members, err := channel.OrganizationMSPIDs(block, groupKey)
+	if err != nil {
+		return nil, err
+	}
+	return &lastConfig{block: block, sequence: sequence, members: members}, nil
+}
+
+// signStoredProposal signs the update of a stored proposal and records the signature
+func signStoredProposal(signer cryptoutil.Signer, id string, update []byte) (*protoutil.ProposalSignature, error) {
+	r, err := openProposal(id)
+	if err != nil {
+		return nil, err
+	}
+	if len(update) > 0 && !bytes.Equal(update, r.Update) {
+		return nil, errors.Errorf("proposal %s does not match the stored proposal", id)
+	}
+	sig, err := signProposal(signer, r.Update)
+	if err != nil {
+		return nil, err
+	}
+	_, err = getProposalStore().Update(id, func(r *proposalstore.Record) error {
+		if err := checkOpen(r); err != nil {
+			return err
+		}
+		r.AddSignature(sig.Creator, sig.Signature)
+		r.SetReady(proposalReady(r))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+// submitStoredProposal submits a stored proposal with the signatures collected
+// so far and the ones of the request, and tracks its state until the config
+// update is committed
+func submitStoredProposal(ctx context.Context, signer cryptoutil.Signer, ordererClient orderer.Client, id string, sigs []*protoutil.ProposalSignature) (*protoutil.Response, error) {
+	r, err := openProposal(id)
+	if err != nil {
+		return nil, err
+	}
+	if r.Creator != signer.GetMSPId() {
+		return nil, errors.Errorf("proposal submit must be the same with sponsor, submit: %s, sponsor:%s", signer.GetMSPId(), r.Creator)
+	}
+	signatures := make(map[string][]byte, len(r.Signatures)+len(sigs))
+	for mspID, sig := range r.Signatures {
+		signatures[mspID] = sig
+	}
+	for _, sig := range sigs {
+		if bytes.Equal(r.ProposalHash, sig.ProposalHash) {
+			signatures[sig.Creator] = sig.Signature
+		}
+	}
+
+	block, err := channel.FetchConfig(ctx, signer, ordererClient, r.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	sequence, err := channel.ConfigSequence(block)
+	if err != nil {
+		return nil, err
+	}
+	if sequence != r.ConfigSequence {
+		err = errors.Errorf("channel %s config changed since the proposal was initiated, sequence %d, now %d", r.ChannelID, r.ConfigSequence, sequence)
+		setProposalState(id, proposalstore.Rejected, err.Error())
+		return failResponse(err), nil
+	}
+
+	if err := authorizeConfigUpdate(block, r.Update, signatures); err != nil {
+		// more signatures can still be collected, the reason is kept for the signers
+		if sdkerrors.Is(err, sdkerrors.PolicyFailure) {
+			setProposalMessage(id, err.Error())
+		}
+		return errorResponse(err)
+	}
+	// the signatures of the request are verified, keep them with the proposal
+	r, err = getProposalStore().Update(id, func(r *proposalstore.Record) error {
+		if err := checkOpen(r); err != nil {
+			return err
+		}
+		for mspID, sig := range signatures {
+			r.AddSignature(mspID, sig)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updateEnvelope, err := channel.CreateUpdateEnvelope(r.Update, r.Signatures, r.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	err = channel.Update(ctx, signer, updateEnvelope, ordererClient)
+	if err != nil {
+		if sdkerrors.Is(err, sdkerrors.OrdererNACK) || sdkerrors.Is(err, sdkerrors.PolicyFailure) {
+			setProposalState(id, proposalstore.Rejected, err.Error())
+		}
+		return failResponse(err), nil
+	}
+	setProposalState(id, proposalstore.Submitted, "")
+
+	if waitConfigCommitted(ctx, signer, ordererClient, r.ChannelID, r.ConfigSequence) {
+		setProposalState(id, proposalstore.Committed, "")
+	}
+	return &protoutil.Response{Status: RESPONSE_OK}, nil
+}
+
+// waitConfigCommitted polls the config of the channel until its sequence is
+// beyond the sequence the proposal was computed against
+func waitConfigCommitted(ctx context.Context, signer cryptoutil.Signer, ordererClient orderer.Client, channelID string, sequence uint64) bool {
+	for i := 0; i < commitPollTimes; i++ {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(commitPollInterval):
+		}
+		block, err := channel.FetchConfig(ctx, signer, ordererClient, channelID)
+		if err != nil {
+			continue
+		}
+		if current, err := channel.ConfigSequence(block); err == nil && current > sequence {
+			return true
+		}
+	}
+	return false
+}
+
+func setProposalState(id string, state proposalstore.State, message string) {
+	// the state is best effort, the result of the submission is reported anyway
+	_, _ = getProposalStore().Update(id, func(r *proposalstore.Record) error {
+		r.State = state
+		r.Message = message
+		return nil
+	})
+}
+
+func setProposalMessage(id string, message string) {
+	_, _ = getProposalStore().Update(id, func(r *proposalstore.Record) error {
+		r.Message = message
+		return nil
+	})
+}
+
+func proposalEnvelope(r *proposalstore.Record) *protoutil.ProposalEnvelope {
+	envelope := &protoutil.ProposalEnvelope{
+		ProposalId: []byte(r.ID),
+		Proposal:   r.Update,
+		Sign: &protoutil.ProposalSignature{
+			ProposalHash: r.ProposalHash,
+			Creator:      r.Creator,
+			Signature:    r.Signatures[r.Creator],
+		},
+		ChannelId: r.ChannelID,
+		State:     protoutil.ProposalState(r.State),
+		Signers:   r.Signers(),
+		Members:   r.Members,
+		Message:   r.Message,
+	}
+	if !r.Deadline.IsZero() {
+		envelope.Deadline = r.Deadline.Unix()
+	}
+	return envelope
+}
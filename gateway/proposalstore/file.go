@@ -0,0 +1,149 @@
+package proposalstore
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const proposalFileExt = ".json"
+
+type fileStore struct {
+	dir string
+	// mutex serializes read-modify-write of proposals within the process
+	mutex sync.Mutex
+}
+
+// NewFileStore creates a store which keeps every proposal as a <id>.json file
+// in dir, the directory is created if it does not exist
+func NewFileStore(dir string) (Store, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "create proposal directory %s", dir)
+	}
+	return &fileStore{dir: dir}, nil
+}
+
+func (s *fileStore) path(id string) string {
+	return filepath.Join(s.dir, id+proposalFileExt)
+}
+
+func (s *fileStore) Create(r *Record) error {
+	if err := prepare(r); err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, err := os.Stat(s.path(r.ID)); err == nil {
+		return errors.Errorf("proposal %s already exists", r.ID)
+	}
+	return s.write(r)
+}
+
+func (s *fileStore) Get(id string) (*Record, error) {
+	if err := validateID(id); err != nil {
+		return nil, err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.read(id)
+}
+
+func (s *fileStore) Update(id string, fn func(r *Record) error) (*Record, error) {
+	if err := validateID(id); err != nil {
+		return nil, err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	r, err := s.read(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := fn(r); err != nil {
+		return nil, err
+	}
+	r.ID = id
+	r.UpdatedAt = time.Now()
+	if err := s.write(r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *fileStore) List() ([]*Record, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "read proposal directory")
+	}
+	var records []*Record
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), proposalFileExt) {
+			continue
+		}
+		r, err := s.read(strings.TrimSuffix(f.Name(), proposalFileExt))
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+	}
+	sortRecords(records)
+	return records, nil
+}
+
+func (s *fileStore) Delete(id string) error {
+	if err := validateID(id); err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	err := os.Remove(s.path(id))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "remove proposal %s", id)
+	}
+	return nil
+}
+
+func (s *fileStore) read(id string) (*Record, error) {
+	data, err := ioutil.ReadFile(s.path(id))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "read proposal %s", id)
+	}
+	r := &Record{}
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil, errors.Wrapf(err, "parse proposal %s", id)
+	}
+	if r.Signatures == nil {
+		r.Signatures = make(map[string][]byte)
+	}
+	return r, nil
+}
+
+func (s *fileStore) write(r *Record) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	// write to a temporary file first so that a reader never sees a partial proposal
+	tmp, err := ioutil.TempFile(s.dir, r.ID+".tmp")
+	if err != nil {
+		return errors.Wrap(err, "create proposal file")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "write proposal file")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "write proposal file")
+	}
+	return errors.Wrap(os.Rename(tmp.Name(), s.path(r.ID)), "write proposal file")
+}
@@ -0,0 +1,75 @@
+package proposalstore
+
+import (
+	"github.com/pkg/errors"
+	"sync"
+	"time"
+)
+
+type memoryStore struct {
+	mutex     sync.Mutex
+	proposals map[string]*Record
+}
+
+// NewInMemoryStore creates a store which holds proposals in memory only
+func NewInMemoryStore() Store {
+	return &memoryStore{proposals: make(map[string]*Record)}
+}
+
+func (s *memoryStore) Create(r *Record) error {
+	if err := prepare(r); err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.proposals[r.ID]; ok {
+		return errors.Errorf("proposal %s already exists", r.ID)
+	}
+	s.proposals[r.ID] = r.copy()
+	return nil
+}
+
+func (s *memoryStore) Get(id string) (*Record, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	r, ok := s.proposals[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return r.copy(), nil
+}
+
+func (s *memoryStore) Update(id string, fn func(r *Record) error) (*Record, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	r, ok := s.proposals[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	updated := r.copy()
+	if err := fn(updated); err != nil {
+		return nil, err
+	}
+	updated.ID = id
+	updated.UpdatedAt = time.Now()
+	s.proposals[id] = updated
+	return updated.copy(), nil
+}
+
+func (s *memoryStore) List() ([]*Record, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	records := make([]*Record, 0, len(s.proposals))
+	for _, r := range s.proposals {
+		records = append(records, r.copy())
+	}
+	sortRecords(records)
+	return records, nil
+}
+
+func (s *memoryStore) Delete(id string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.proposals, id)
+	return nil
+}
@@ -0,0 +1,227 @@
+package proposalstore
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"github.com/pkg/errors"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrNotFound is returned when there is no proposal of the id
+	ErrNotFound = errors.New("proposal not found")
+	// ErrExpired is returned when a proposal is signed or submitted after its deadline
+	ErrExpired = errors.New("proposal expired")
+	// ErrClosed is returned when a proposal is signed or submitted after it was
+	// submitted, committed or rejected
+	ErrClosed = errors.New("proposal closed")
+)
+
+// State is the lifecycle state of a config update proposal, the values are
+// equal to the ProposalState enum of the gateway protos
+type State int32
+
+const (
+	StateUnknown State = iota
+	// Initiated is a proposal signed by its initiator only
+	Initiated
+	// CollectingSignatures is a proposal signed by other members as well
+	CollectingSignatures
+	// Ready is a proposal whose signatures satisfy the policies of its update
+	Ready
+	// Submitted is a proposal accepted by the orderer
+	Submitted
+	// Committed is a proposal whose config update is in the channel config
+	Committed
+	// Expired is a proposal not submitted before its deadline
+	Expired
+	// Rejected is a proposal refused by the orderer or outdated by another config update
+	Rejected
+)
+
+var stateNames = map[State]string{
+	StateUnknown:         "UNKNOWN",
+	Initiated:            "INITIATED",
+	CollectingSignatures: "COLLECTING_SIGNATURES",
+	Ready:                "READY",
+	Submitted:            "SUBMITTED",
+	Committed:            "COMMITTED",
+	Expired:              "EXPIRED",
+	Rejected:             "REJECTED",
+}
+
+func (s State) String() string {
+	if name, ok := stateNames[s]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// Final reports whether the proposal can not change any more
+func (s State) Final() bool {
+	return s == Committed || s == Expired || s == Rejected
+}
+
+// Record is a config update proposal tracked by the store
+type Record struct {
+	ID        string `json:"id"`
+	Type      int32  `json:"type"`
+	ChannelID string `json:"channelId"`
+	// Update is the marshaled common.ConfigUpdate
+	Update       []byte `json:"update"`
+	ProposalHash []byte `json:"proposalHash"`
+	// Creator is the MSP ID of the initiator
+	Creator string `json:"creator"`
+	// Signatures are the marshaled common.ConfigSignature by MSP ID
+	Signatures map[string][]byte `json:"signatures"`
+	// Members are the MSP IDs of the organizations expected to sign
+	Members []string `json:"members,omitempty"`
+	// ConfigSequence is the sequence of the channel config the update is computed against
+	ConfigSequence uint64    `json:"configSequence"`
+	State          State     `json:"state"`
+	Message        string    `json:"message,omitempty"`
+	Deadline       time.Time `json:"deadline,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+	// ConfigBlock is the marshaled config block the update is computed against,
+	// the signatures are checked against its policies
+	ConfigBlock []byte `json:"configBlock,omitempty"`
+}
+
+// Signers returns the sorted MSP IDs which have signed the proposal
+func (r *Record) Signers() []string {
+	signers := make([]string, 0, len(r.Signatures))
+	for mspID := range r.Signatures {
+		signers = append(signers, mspID)
+	}
+	sort.Strings(signers)
+	return signers
+}
+
+// Expire moves the proposal to Expired if its deadline has passed, it reports
+// whether the proposal is expired
+func (r *Record) Expire(now time.Time) bool {
+	if r.State == Expired {
+		return true
+	}
+	if r.State.Final() || r.State == Submitted || r.Deadline.IsZero() || now.Before(r.Deadline) {
+		return false
+	}
+	r.State = Expired
+	r.Message = "deadline passed before the proposal was submitted"
+	r.UpdatedAt = now
+	return true
+}
+
+// AddSignature records the signature of the MSP, the proposal is collecting
+// signatures once another organization has signed
+func (r *Record) AddSignature(mspID string, signature []byte) {
+	if r.Signatures == nil {
+		r.Signatures = make(map[string][]byte)
+	}
+	r.Signatures[mspID] = signature
+	if r.State != Ready {
+		r.SetReady(false)
+	}
+}
+
+// SetReady records whether the signatures collected so far satisfy the
+// policies of the update, it only changes a proposal still open for signatures
+func (r *Record) SetReady(ready bool) {
+	if r.State != StateUnknown && r.State != Initiated && r.State != CollectingSignatures && r.State != Ready {
+		return
+	}
+	switch {
+	case ready:
+		r.State = Ready
+	case len(r.Signatures) > 1:
+		r.State = CollectingSignatures
+	default:
+		r.State = Initiated
+	}
+}
+
+func (r *Record) copy() *Record {
+	c := *r
+	c.Update = append([]byte(nil), r.Update...)
+	c.ProposalHash = append([]byte(nil), r.ProposalHash...)
+	c.Members = append([]string(nil), r.Members...)
+	c.ConfigBlock = append([]byte(nil), r.ConfigBlock...)
+	c.Signatures = make(map[string][]byte, len(r.Signatures))
+	for mspID, sig := range r.Signatures {
+		c.Signatures[mspID] = append([]byte(nil), sig...)
+	}
+	return &c
+}
+
+// Store persists proposals so that a multi-party config update can be
+// collected over days
+type Store interface {
+	// Create stores a new proposal, its ID is assigned if empty
+	Create(r *Record) error
+	// Get returns the proposal of the id, ErrNotFound if there is none
+	Get(id string) (*Record, error)
+	// Update applies fn to the proposal of the id and stores the result, the
+	// proposal is left unchanged if fn returns an error
+	Update(id string, fn func(r *Record) error) (*Record, error)
+	// List returns all proposals sorted by creation time
+	List() ([]*Record, error)
+	// Delete removes the proposal of the id
+	Delete(id string) error
+}
+
+// NewID returns a random proposal id
+func NewID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "generate proposal id")
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func prepare(r *Record) error {
+	if r == nil {
+		return errors.New("proposal is nil")
+	}
+	if r.ID == "" {
+		id, err := NewID()
+		if err != nil {
+			return err
+		}
+		r.ID = id
+	}
+	if err := validateID(r.ID); err != nil {
+		return err
+	}
+	if r.Signatures == nil {
+		r.Signatures = make(map[string][]byte)
+	}
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = time.Now()
+	}
+	if r.UpdatedAt.IsZero() {
+		r.UpdatedAt = r.CreatedAt
+	}
+	return nil
+}
+
+func validateID(id string) error {
+	if id == "" {
+		return errors.New("proposal id is required")
+	}
+	if strings.ContainsAny(id, `/\`) || id == "." || id == ".." {
+		return errors.Errorf("invalid proposal id %q", id)
+	}
+	return nil
+}
+
+func sortRecords(records []*Record) {
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].CreatedAt.Equal(records[j].CreatedAt) {
+			return records[i].ID < records[j].ID
+		}
+		return records[i].CreatedAt.Before(records[j].CreatedAt)
+	})
+}
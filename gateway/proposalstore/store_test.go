@@ -0,0 +1,150 @@
+package proposalstore
+
+import (
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func testStore(t *testing.T, s Store) {
+	r := &Record{
+		ChannelID: "mychannel",
+		Update:    []byte("update"),
+		Creator:   "Org1MSP",
+		Members:   []string{"Org1MSP", "Org2MSP", "Org3MSP"},
+	}
+	r.AddSignature("Org1MSP", []byte("sig1"))
+	if err := s.Create(r); err != nil {
+		t.Fatal(err)
+	}
+	if r.ID == "" {
+		t.Fatal("expected an id to be assigned")
+	}
+	if err := s.Create(r); err == nil {
+		t.Fatal("expected duplicated proposal to be rejected")
+	}
+
+	got, err := s.Get(r.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != Initiated || string(got.Signatures["Org1MSP"]) != "sig1" || got.ChannelID != "mychannel" {
+		t.Fatalf("unexpected proposal %+v", got)
+	}
+
+	got, err = s.Update(r.ID, func(r *Record) error {
+		r.AddSignature("Org2MSP", []byte("sig2"))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != CollectingSignatures {
+		t.Fatalf("expected the proposal to collect signatures, got %s", got.State)
+	}
+	got, err = s.Update(r.ID, func(r *Record) error {
+		r.SetReady(true)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != Ready {
+		t.Fatalf("expected the proposal to be ready, got %s", got.State)
+	}
+
+	// a failed update leaves the proposal unchanged
+	if _, err := s.Update(r.ID, func(r *Record) error {
+		r.State = Rejected
+		return errors.New("failed")
+	}); err == nil {
+		t.Fatal("expected the error of the update")
+	}
+	if got, _ = s.Get(r.ID); got.State != Ready {
+		t.Fatalf("proposal should be unchanged, got %s", got.State)
+	}
+	if signers := got.Signers(); len(signers) != 2 || signers[0] != "Org1MSP" || signers[1] != "Org2MSP" {
+		t.Fatalf("unexpected signers %v", signers)
+	}
+
+	if err := s.Create(&Record{ID: "../p"}); err == nil {
+		t.Fatal("expected invalid id to be rejected")
+	}
+	if err := s.Create(&Record{ChannelID: "other"}); err != nil {
+		t.Fatal(err)
+	}
+	records, err := s.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].ID != r.ID {
+		t.Fatalf("unexpected proposals %v", records)
+	}
+
+	if err := s.Delete(r.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get(r.ID); err != ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+	if _, err := s.Update(r.ID, func(*Record) error { return nil }); err != ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestInMemoryStore(t *testing.T) {
+	testStore(t, NewInMemoryStore())
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "proposals")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStore(t, s)
+
+	// proposals survive a restart of the store
+	r := &Record{Creator: "Org1MSP", Deadline: time.Now().Add(time.Hour).Round(time.Second)}
+	if err := s.Create(r); err != nil {
+		t.Fatal(err)
+	}
+	s, err = NewFileStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.Get(r.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Deadline.Equal(r.Deadline) || got.Creator != "Org1MSP" {
+		t.Fatalf("unexpected proposal %+v", got)
+	}
+}
+
+func TestRecordExpire(t *testing.T) {
+	now := time.Now()
+	r := &Record{State: CollectingSignatures, Deadline: now.Add(time.Minute)}
+	if r.Expire(now) {
+		t.Fatal("proposal should not expire before its deadline")
+	}
+	if !r.Expire(now.Add(time.Minute)) || r.State != Expired {
+		t.Fatalf("proposal should expire at its deadline, got %s", r.State)
+	}
+
+	r = &Record{State: Submitted, Deadline: now.Add(-time.Minute)}
+	if r.Expire(now) {
+		t.Fatal("a submitted proposal should not expire")
+	}
+	r = &Record{State: Ready}
+	if r.Expire(now) {
+		t.Fatal("a proposal without deadline should not expire")
+	}
+}
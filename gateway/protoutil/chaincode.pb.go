@@ -86,13 +86,16 @@ type Chaincode struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lang string `protobuf:"bytes,1,opt,name=lang,proto3" json:"lang,omitempty"`
+	Lang string `protobuf:"bytes,1,opt,name=lang,proto3" json:"lang,omitempty"` // golang, node 或 java
 	// Types that are assignable to Package:
 	//	*Chaincode_Source
 	//	*Chaincode_File
 	//	*Chaincode_GitRepo
 	//	*Chaincode_PkgBytes
 	Package isChaincode_Package `protobuf_oneof:"Package"`
+	Label   string              `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`                 // 源码和git仓库安装时的包标签
+	Path    string              `protobuf:"bytes,7,opt,name=path,proto3" json:"path,omitempty"`                   // 链码在源码目录或者仓库中的相对路径
+	GitRef  string              `protobuf:"bytes,8,opt,name=git_ref,json=gitRef,proto3" json:"git_ref,omitempty"` // 分支、标签或者提交，为空时使用默认分支
 }
 
 func (x *Chaincode) Reset() {
@@ -169,12 +172,33 @@ func (x *Chaincode) GetPkgBytes() []byte {
 	return nil
 }
 
+func (x *Chaincode) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Chaincode) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Chaincode) GetGitRef() string {
+	if x != nil {
+		return x.GitRef
+	}
+	return ""
+}
+
 type isChaincode_Package interface {
 	isChaincode_Package()
 }
 
 type Chaincode_Source struct {
-	Source []byte `protobuf:"bytes,2,opt,name=source,proto3,oneof"`
+	Source []byte `protobuf:"bytes,2,opt,name=source,proto3,oneof"` // 链码目录的tar.gz
 }
 
 type Chaincode_File struct {
@@ -182,7 +206,7 @@ type Chaincode_File struct {
 }
 
 type Chaincode_GitRepo struct {
-	GitRepo string `protobuf:"bytes,4,opt,name=git_repo,json=gitRepo,proto3,oneof"`
+	GitRepo string `protobuf:"bytes,4,opt,name=git_repo,json=gitRepo,proto3,oneof"` // 本地路径或者 file:// 仓库
 }
 
 type Chaincode_PkgBytes struct {
@@ -647,18 +671,18 @@ func (x *ChaincodeInstallResponse) GetResults() []*ChaincodeInstallResponse_Resu
 	return nil
 }
 
-type ChaincodeArgs struct {
+// 部署链码的组织，signer 为组织的管理员；链码安装在 peers 上，第一个节点用于授信和背书
+type DeployOrg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Args    [][]byte `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	Signer *Signer `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Peers  []*Peer `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
 }
 
-func (x *ChaincodeArgs) Reset() {
-	*x = ChaincodeArgs{}
+func (x *DeployOrg) Reset() {
+	*x = DeployOrg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chaincode_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -666,13 +690,13 @@ func (x *ChaincodeArgs) Reset() {
 	}
 }
 
-func (x *ChaincodeArgs) String() string {
+func (x *DeployOrg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChaincodeArgs) ProtoMessage() {}
+func (*DeployOrg) ProtoMessage() {}
 
-func (x *ChaincodeArgs) ProtoReflect() protoreflect.Message {
+func (x *DeployOrg) ProtoReflect() protoreflect.Message {
 	mi := &file_chaincode_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -684,47 +708,49 @@ func (x *ChaincodeArgs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChaincodeArgs.ProtoReflect.Descriptor instead.
-func (*ChaincodeArgs) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeployOrg.ProtoReflect.Descriptor instead.
+func (*DeployOrg) Descriptor() ([]byte, []int) {
 	return file_chaincode_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ChaincodeArgs) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *ChaincodeArgs) GetVersion() string {
+func (x *DeployOrg) GetSigner() *Signer {
 	if x != nil {
-		return x.Version
+		return x.Signer
 	}
-	return ""
+	return nil
 }
 
-func (x *ChaincodeArgs) GetArgs() [][]byte {
+func (x *DeployOrg) GetPeers() []*Peer {
 	if x != nil {
-		return x.Args
+		return x.Peers
 	}
 	return nil
 }
 
-type ContractInvokeRequest struct {
+// 一次完成链码在各组织的安装、授信、提交和初始化，重复调用时跳过已完成的步骤
+type ChaincodeDeployRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signer    *Signer        `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Endorsers []*Peer        `protobuf:"bytes,2,rep,name=endorsers,proto3" json:"endorsers,omitempty"`
-	Committer *Peer          `protobuf:"bytes,3,opt,name=committer,proto3" json:"committer,omitempty"`
-	Orderer   *Orderer       `protobuf:"bytes,4,opt,name=orderer,proto3" json:"orderer,omitempty"`
-	Args      *ChaincodeArgs `protobuf:"bytes,5,opt,name=args,proto3" json:"args,omitempty"`
-	ChannelId string         `protobuf:"bytes,6,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-}
-
-func (x *ContractInvokeRequest) Reset() {
-	*x = ContractInvokeRequest{}
+	Chaincode *ChaincodePackage `protobuf:"bytes,1,opt,name=chaincode,proto3" json:"chaincode,omitempty"`
+	// 第一个组织的管理员提交链码定义并调用Init
+	Orgs      []*DeployOrg `protobuf:"bytes,2,rep,name=orgs,proto3" json:"orgs,omitempty"`
+	Orderer   *Orderer     `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	ChannelId string       `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence 为0时根据通道上已提交的定义计算
+	Definition          *DefinitionArgs `protobuf:"bytes,5,opt,name=definition,proto3" json:"definition,omitempty"`
+	SignaturePolicy     string          `protobuf:"bytes,6,opt,name=signature_policy,json=signaturePolicy,proto3" json:"signature_policy,omitempty"`
+	ChannelConfigPolicy string          `protobuf:"bytes,7,opt,name=channel_config_policy,json=channelConfigPolicy,proto3" json:"channel_config_policy,omitempty"`
+	CollectionConfig    []byte          `protobuf:"bytes,8,opt,name=collection_config,json=collectionConfig,proto3" json:"collection_config,omitempty"`
+	// definition.init_required 为true时调用Init的参数
+	InitArgs [][]byte `protobuf:"bytes,9,rep,name=init_args,json=initArgs,proto3" json:"init_args,omitempty"`
+	// 等待所有组织授信的超时时间，单位秒，默认60秒
+	ApproveTimeout int64 `protobuf:"varint,10,opt,name=approve_timeout,json=approveTimeout,proto3" json:"approve_timeout,omitempty"`
+}
+
+func (x *ChaincodeDeployRequest) Reset() {
+	*x = ChaincodeDeployRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chaincode_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -732,13 +758,13 @@ func (x *ContractInvokeRequest) Reset() {
 	}
 }
 
-func (x *ContractInvokeRequest) String() string {
+func (x *ChaincodeDeployRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContractInvokeRequest) ProtoMessage() {}
+func (*ChaincodeDeployRequest) ProtoMessage() {}
 
-func (x *ContractInvokeRequest) ProtoReflect() protoreflect.Message {
+func (x *ChaincodeDeployRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_chaincode_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -750,67 +776,99 @@ func (x *ContractInvokeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContractInvokeRequest.ProtoReflect.Descriptor instead.
-func (*ContractInvokeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChaincodeDeployRequest.ProtoReflect.Descriptor instead.
+func (*ChaincodeDeployRequest) Descriptor() ([]byte, []int) {
 	return file_chaincode_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ContractInvokeRequest) GetSigner() *Signer {
+func (x *ChaincodeDeployRequest) GetChaincode() *ChaincodePackage {
 	if x != nil {
-		return x.Signer
+		return x.Chaincode
 	}
 	return nil
 }
 
-func (x *ContractInvokeRequest) GetEndorsers() []*Peer {
+func (x *ChaincodeDeployRequest) GetOrgs() []*DeployOrg {
 	if x != nil {
-		return x.Endorsers
+		return x.Orgs
 	}
 	return nil
 }
 
-func (x *ContractInvokeRequest) GetCommitter() *Peer {
+func (x *ChaincodeDeployRequest) GetOrderer() *Orderer {
 	if x != nil {
-		return x.Committer
+		return x.Orderer
 	}
 	return nil
 }
 
-func (x *ContractInvokeRequest) GetOrderer() *Orderer {
+func (x *ChaincodeDeployRequest) GetChannelId() string {
 	if x != nil {
-		return x.Orderer
+		return x.ChannelId
 	}
-	return nil
+	return ""
 }
 
-func (x *ContractInvokeRequest) GetArgs() *ChaincodeArgs {
+func (x *ChaincodeDeployRequest) GetDefinition() *DefinitionArgs {
 	if x != nil {
-		return x.Args
+		return x.Definition
 	}
 	return nil
 }
 
-func (x *ContractInvokeRequest) GetChannelId() string {
+func (x *ChaincodeDeployRequest) GetSignaturePolicy() string {
 	if x != nil {
-		return x.ChannelId
+		return x.SignaturePolicy
 	}
 	return ""
 }
 
-type ContractQueryRequest struct {
+func (x *ChaincodeDeployRequest) GetChannelConfigPolicy() string {
+	if x != nil {
+		return x.ChannelConfigPolicy
+	}
+	return ""
+}
+
+func (x *ChaincodeDeployRequest) GetCollectionConfig() []byte {
+	if x != nil {
+		return x.CollectionConfig
+	}
+	return nil
+}
+
+func (x *ChaincodeDeployRequest) GetInitArgs() [][]byte {
+	if x != nil {
+		return x.InitArgs
+	}
+	return nil
+}
+
+func (x *ChaincodeDeployRequest) GetApproveTimeout() int64 {
+	if x != nil {
+		return x.ApproveTimeout
+	}
+	return 0
+}
+
+// 部署的一个步骤
+type DeployStep struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signer    *Signer        `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Committer *Peer          `protobuf:"bytes,2,opt,name=committer,proto3" json:"committer,omitempty"`
-	Orderer   *Orderer       `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
-	Args      *ChaincodeArgs `protobuf:"bytes,4,opt,name=args,proto3" json:"args,omitempty"`
-	ChannelId string         `protobuf:"bytes,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`     // install, approve, commit 或 init
+	Target  string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"` // 节点地址、组织的MSP ID 或者通道
+	Status  int32  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// 该步骤此前已完成，本次跳过
+	Skipped      bool           `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	ErrorCode    ErrorCode      `protobuf:"varint,6,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	ErrorDetails []*ErrorDetail `protobuf:"bytes,7,rep,name=error_details,json=errorDetails,proto3" json:"error_details,omitempty"`
 }
 
-func (x *ContractQueryRequest) Reset() {
-	*x = ContractQueryRequest{}
+func (x *DeployStep) Reset() {
+	*x = DeployStep{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chaincode_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -818,13 +876,13 @@ func (x *ContractQueryRequest) Reset() {
 	}
 }
 
-func (x *ContractQueryRequest) String() string {
+func (x *DeployStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContractQueryRequest) ProtoMessage() {}
+func (*DeployStep) ProtoMessage() {}
 
-func (x *ContractQueryRequest) ProtoReflect() protoreflect.Message {
+func (x *DeployStep) ProtoReflect() protoreflect.Message {
 	mi := &file_chaincode_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -836,58 +894,75 @@ func (x *ContractQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContractQueryRequest.ProtoReflect.Descriptor instead.
-func (*ContractQueryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeployStep.ProtoReflect.Descriptor instead.
+func (*DeployStep) Descriptor() ([]byte, []int) {
 	return file_chaincode_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ContractQueryRequest) GetSigner() *Signer {
+func (x *DeployStep) GetName() string {
 	if x != nil {
-		return x.Signer
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ContractQueryRequest) GetCommitter() *Peer {
+func (x *DeployStep) GetTarget() string {
 	if x != nil {
-		return x.Committer
+		return x.Target
 	}
-	return nil
+	return ""
 }
 
-func (x *ContractQueryRequest) GetOrderer() *Orderer {
+func (x *DeployStep) GetStatus() int32 {
 	if x != nil {
-		return x.Orderer
+		return x.Status
 	}
-	return nil
+	return 0
 }
 
-func (x *ContractQueryRequest) GetArgs() *ChaincodeArgs {
+func (x *DeployStep) GetMessage() string {
 	if x != nil {
-		return x.Args
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-func (x *ContractQueryRequest) GetChannelId() string {
+func (x *DeployStep) GetSkipped() bool {
 	if x != nil {
-		return x.ChannelId
+		return x.Skipped
 	}
-	return ""
+	return false
 }
 
-type ChaincodeInstallResponse_Result struct {
+func (x *DeployStep) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_NO_ERROR
+}
+
+func (x *DeployStep) GetErrorDetails() []*ErrorDetail {
+	if x != nil {
+		return x.ErrorDetails
+	}
+	return nil
+}
+
+// 部署的结果，某个步骤失败时 status 为 500，steps 到失败的步骤为止
+type ChaincodeDeployResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status  int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Status    int32         `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Message   string        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PackageId string        `protobuf:"bytes,3,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	Sequence  int64         `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Steps     []*DeployStep `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps,omitempty"`
 }
 
-func (x *ChaincodeInstallResponse_Result) Reset() {
-	*x = ChaincodeInstallResponse_Result{}
+func (x *ChaincodeDeployResponse) Reset() {
+	*x = ChaincodeDeployResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chaincode_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -895,13 +970,13 @@ func (x *ChaincodeInstallResponse_Result) Reset() {
 	}
 }
 
-func (x *ChaincodeInstallResponse_Result) String() string {
+func (x *ChaincodeDeployResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChaincodeInstallResponse_Result) ProtoMessage() {}
+func (*ChaincodeDeployResponse) ProtoMessage() {}
 
-func (x *ChaincodeInstallResponse_Result) ProtoReflect() protoreflect.Message {
+func (x *ChaincodeDeployResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_chaincode_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -913,285 +988,2221 @@ func (x *ChaincodeInstallResponse_Result) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChaincodeInstallResponse_Result.ProtoReflect.Descriptor instead.
-func (*ChaincodeInstallResponse_Result) Descriptor() ([]byte, []int) {
-	return file_chaincode_proto_rawDescGZIP(), []int{6, 0}
+// Deprecated: Use ChaincodeDeployResponse.ProtoReflect.Descriptor instead.
+func (*ChaincodeDeployResponse) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ChaincodeInstallResponse_Result) GetId() string {
+func (x *ChaincodeDeployResponse) GetStatus() int32 {
 	if x != nil {
-		return x.Id
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *ChaincodeInstallResponse_Result) GetStatus() int32 {
+func (x *ChaincodeDeployResponse) GetMessage() string {
 	if x != nil {
-		return x.Status
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-func (x *ChaincodeInstallResponse_Result) GetMessage() string {
+func (x *ChaincodeDeployResponse) GetPackageId() string {
 	if x != nil {
-		return x.Message
+		return x.PackageId
 	}
 	return ""
 }
 
-var File_chaincode_proto protoreflect.FileDescriptor
+func (x *ChaincodeDeployResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
 
-var file_chaincode_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x1a, 0x0c, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x96, 0x01, 0x0a, 0x09, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x08,
-	0x67, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x07, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x1d, 0x0a, 0x09, 0x70, 0x6b, 0x67,
-	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08,
-	0x70, 0x6b, 0x67, 0x42, 0x79, 0x74, 0x65, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x22, 0xee, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4d, 0x6f, 0x64,
-	0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x67, 0x0a, 0x0d, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x12,
-	0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x42, 0x59, 0x54,
-	0x45, 0x53, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x50, 0x41, 0x43,
-	0x4b, 0x41, 0x47, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x46,
-	0x52, 0x4f, 0x4d, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10,
-	0x02, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x47, 0x49, 0x54, 0x5f, 0x52, 0x45,
-	0x50, 0x4f, 0x10, 0x03, 0x22, 0xf8, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x5f, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x64, 0x6f, 0x72,
-	0x73, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e,
-	0x69, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22,
-	0xa0, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72,
-	0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x22, 0x91, 0x02, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
-	0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x06,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
-	0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x12, 0x1d, 0x0a,
-	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a,
-	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x44, 0x65, 0x66,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x52, 0x0a, 0x64, 0x65, 0x66,
-	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x65, 0x6e, 0x64,
-	0x6f, 0x72, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x6f,
-	0x72, 0x73, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
-	0x39, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e,
-	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x52, 0x0a,
-	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf9, 0x01, 0x0a, 0x18, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x4a, 0x0a, 0x06, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x51, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x8f, 0x02, 0x0a, 0x15, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x65,
-	0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x09, 0x65, 0x6e,
-	0x64, 0x6f, 0x72, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x12, 0x2c,
-	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x41, 0x72, 0x67, 0x73, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xe2, 0x01, 0x0a, 0x14,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69,
-	0x67, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09,
-	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x09, 0x63,
-	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x72, 0x67, 0x73, 0x52, 0x04, 0x61, 0x72, 0x67,
-	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
-	0x32, 0x84, 0x02, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x53, 0x74,
-	0x75, 0x62, 0x12, 0x5d, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4a, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a,
-	0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x12, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x8c, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x75, 0x62, 0x12, 0x3e, 0x0a, 0x06, 0x49, 0x6e, 0x76, 0x6f,
-	0x6b, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x13, 0x5a, 0x11, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+func (x *ChaincodeDeployResponse) GetSteps() []*DeployStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
 }
 
-var (
-	file_chaincode_proto_rawDescOnce sync.Once
-	file_chaincode_proto_rawDescData = file_chaincode_proto_rawDesc
-)
+// 查询节点上安装的链码
+type ChaincodeQueryInstalledRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_chaincode_proto_rawDescGZIP() []byte {
-	file_chaincode_proto_rawDescOnce.Do(func() {
-		file_chaincode_proto_rawDescData = protoimpl.X.CompressGZIP(file_chaincode_proto_rawDescData)
-	})
-	return file_chaincode_proto_rawDescData
+	Signer *Signer `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Peer   *Peer   `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
 }
 
-var file_chaincode_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_chaincode_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
-var file_chaincode_proto_goTypes = []interface{}{
-	(ChaincodePackage_ChaincodeMode)(0),     // 0: chaincode.ChaincodePackage.ChaincodeMode
-	(*Chaincode)(nil),                       // 1: chaincode.Chaincode
-	(*ChaincodePackage)(nil),                // 2: chaincode.ChaincodePackage
-	(*DefinitionArgs)(nil),                  // 3: chaincode.DefinitionArgs
-	(*ChaincodeInstallRequest)(nil),         // 4: chaincode.ChaincodeInstallRequest
-	(*ChaincodeApproveRequest)(nil),         // 5: chaincode.ChaincodeApproveRequest
-	(*ChaincodeCommitRequest)(nil),          // 6: chaincode.ChaincodeCommitRequest
-	(*ChaincodeInstallResponse)(nil),        // 7: chaincode.ChaincodeInstallResponse
-	(*ChaincodeArgs)(nil),                   // 8: chaincode.ChaincodeArgs
-	(*ContractInvokeRequest)(nil),           // 9: chaincode.ContractInvokeRequest
-	(*ContractQueryRequest)(nil),            // 10: chaincode.ContractQueryRequest
-	(*ChaincodeInstallResponse_Result)(nil), // 11: chaincode.ChaincodeInstallResponse.Result
-	(*Signer)(nil),                          // 12: common.Signer
-	(*Peer)(nil),                            // 13: common.Peer
-	(*Orderer)(nil),                         // 14: common.Orderer
-	(*Response)(nil),                        // 15: common.Response
+func (x *ChaincodeQueryInstalledRequest) Reset() {
+	*x = ChaincodeQueryInstalledRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_chaincode_proto_depIdxs = []int32{
-	0,  // 0: chaincode.ChaincodePackage.mode:type_name -> chaincode.ChaincodePackage.ChaincodeMode
-	1,  // 1: chaincode.ChaincodePackage.chaincode:type_name -> chaincode.Chaincode
-	12, // 2: chaincode.ChaincodeInstallRequest.signer:type_name -> common.Signer
-	13, // 3: chaincode.ChaincodeInstallRequest.peers:type_name -> common.Peer
-	2,  // 4: chaincode.ChaincodeInstallRequest.chaincode:type_name -> chaincode.ChaincodePackage
-	12, // 5: chaincode.ChaincodeApproveRequest.signer:type_name -> common.Signer
-	13, // 6: chaincode.ChaincodeApproveRequest.committer:type_name -> common.Peer
-	14, // 7: chaincode.ChaincodeApproveRequest.orderer:type_name -> common.Orderer
-	3,  // 8: chaincode.ChaincodeApproveRequest.definition:type_name -> chaincode.DefinitionArgs
-	12, // 9: chaincode.ChaincodeCommitRequest.signer:type_name -> common.Signer
-	13, // 10: chaincode.ChaincodeCommitRequest.endorsers:type_name -> common.Peer
-	14, // 11: chaincode.ChaincodeCommitRequest.orderer:type_name -> common.Orderer
-	3,  // 12: chaincode.ChaincodeCommitRequest.definition:type_name -> chaincode.DefinitionArgs
-	11, // 13: chaincode.ChaincodeInstallResponse.results:type_name -> chaincode.ChaincodeInstallResponse.Result
-	12, // 14: chaincode.ContractInvokeRequest.signer:type_name -> common.Signer
-	13, // 15: chaincode.ContractInvokeRequest.endorsers:type_name -> common.Peer
-	13, // 16: chaincode.ContractInvokeRequest.committer:type_name -> common.Peer
-	14, // 17: chaincode.ContractInvokeRequest.orderer:type_name -> common.Orderer
-	8,  // 18: chaincode.ContractInvokeRequest.args:type_name -> chaincode.ChaincodeArgs
-	12, // 19: chaincode.ContractQueryRequest.signer:type_name -> common.Signer
-	13, // 20: chaincode.ContractQueryRequest.committer:type_name -> common.Peer
-	14, // 21: chaincode.ContractQueryRequest.orderer:type_name -> common.Orderer
-	8,  // 22: chaincode.ContractQueryRequest.args:type_name -> chaincode.ChaincodeArgs
-	4,  // 23: chaincode.ChaincodeStub.InstallChaincode:input_type -> chaincode.ChaincodeInstallRequest
-	5,  // 24: chaincode.ChaincodeStub.ApproveChaincode:input_type -> chaincode.ChaincodeApproveRequest
-	6,  // 25: chaincode.ChaincodeStub.CommitChaincode:input_type -> chaincode.ChaincodeCommitRequest
-	9,  // 26: chaincode.ContractStub.Invoke:input_type -> chaincode.ContractInvokeRequest
-	10, // 27: chaincode.ContractStub.Query:input_type -> chaincode.ContractQueryRequest
-	7,  // 28: chaincode.ChaincodeStub.InstallChaincode:output_type -> chaincode.ChaincodeInstallResponse
-	15, // 29: chaincode.ChaincodeStub.ApproveChaincode:output_type -> common.Response
-	15, // 30: chaincode.ChaincodeStub.CommitChaincode:output_type -> common.Response
-	15, // 31: chaincode.ContractStub.Invoke:output_type -> common.Response
-	15, // 32: chaincode.ContractStub.Query:output_type -> common.Response
-	28, // [28:33] is the sub-list for method output_type
-	23, // [23:28] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+
+func (x *ChaincodeQueryInstalledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_chaincode_proto_init() }
-func file_chaincode_proto_init() {
-	if File_chaincode_proto != nil {
-		return
+func (*ChaincodeQueryInstalledRequest) ProtoMessage() {}
+
+func (x *ChaincodeQueryInstalledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	file_common_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_chaincode_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Chaincode); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeQueryInstalledRequest.ProtoReflect.Descriptor instead.
+func (*ChaincodeQueryInstalledRequest) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ChaincodeQueryInstalledRequest) GetSigner() *Signer {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *ChaincodeQueryInstalledRequest) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+type InstalledChaincode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageId  string                          `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	Label      string                          `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	References []*InstalledChaincode_Reference `protobuf:"bytes,3,rep,name=references,proto3" json:"references,omitempty"`
+}
+
+func (x *InstalledChaincode) Reset() {
+	*x = InstalledChaincode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstalledChaincode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstalledChaincode) ProtoMessage() {}
+
+func (x *InstalledChaincode) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstalledChaincode.ProtoReflect.Descriptor instead.
+func (*InstalledChaincode) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *InstalledChaincode) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *InstalledChaincode) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *InstalledChaincode) GetReferences() []*InstalledChaincode_Reference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+type ChaincodeQueryInstalledResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chaincodes []*InstalledChaincode `protobuf:"bytes,1,rep,name=chaincodes,proto3" json:"chaincodes,omitempty"`
+}
+
+func (x *ChaincodeQueryInstalledResponse) Reset() {
+	*x = ChaincodeQueryInstalledResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeQueryInstalledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeQueryInstalledResponse) ProtoMessage() {}
+
+func (x *ChaincodeQueryInstalledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeQueryInstalledResponse.ProtoReflect.Descriptor instead.
+func (*ChaincodeQueryInstalledResponse) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ChaincodeQueryInstalledResponse) GetChaincodes() []*InstalledChaincode {
+	if x != nil {
+		return x.Chaincodes
+	}
+	return nil
+}
+
+// 从节点下载已安装的链码包
+type ChaincodeGetPackageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer    *Signer `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Peer      *Peer   `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	PackageId string  `protobuf:"bytes,3,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+}
+
+func (x *ChaincodeGetPackageRequest) Reset() {
+	*x = ChaincodeGetPackageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeGetPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeGetPackageRequest) ProtoMessage() {}
+
+func (x *ChaincodeGetPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeGetPackageRequest.ProtoReflect.Descriptor instead.
+func (*ChaincodeGetPackageRequest) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ChaincodeGetPackageRequest) GetSigner() *Signer {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *ChaincodeGetPackageRequest) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+func (x *ChaincodeGetPackageRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+type ChaincodeGetPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PkgBytes []byte `protobuf:"bytes,1,opt,name=pkg_bytes,json=pkgBytes,proto3" json:"pkg_bytes,omitempty"`
+}
+
+func (x *ChaincodeGetPackageResponse) Reset() {
+	*x = ChaincodeGetPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeGetPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeGetPackageResponse) ProtoMessage() {}
+
+func (x *ChaincodeGetPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeGetPackageResponse.ProtoReflect.Descriptor instead.
+func (*ChaincodeGetPackageResponse) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChaincodeGetPackageResponse) GetPkgBytes() []byte {
+	if x != nil {
+		return x.PkgBytes
+	}
+	return nil
+}
+
+// 查询组织授信的链码定义，须使用组织自己的节点
+type ChaincodeQueryApprovedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer    *Signer `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Peer      *Peer   `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	ChannelId string  `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name      string  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// 为0时查询最新授信的定义
+	Sequence int64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *ChaincodeQueryApprovedRequest) Reset() {
+	*x = ChaincodeQueryApprovedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeQueryApprovedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeQueryApprovedRequest) ProtoMessage() {}
+
+func (x *ChaincodeQueryApprovedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeQueryApprovedRequest.ProtoReflect.Descriptor instead.
+func (*ChaincodeQueryApprovedRequest) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ChaincodeQueryApprovedRequest) GetSigner() *Signer {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *ChaincodeQueryApprovedRequest) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+func (x *ChaincodeQueryApprovedRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChaincodeQueryApprovedRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChaincodeQueryApprovedRequest) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+type ChaincodeQueryApprovedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Definition *DefinitionArgs `protobuf:"bytes,1,opt,name=definition,proto3" json:"definition,omitempty"`
+	// 授信的链码包，为空时组织没有为该定义安装链码
+	PackageId string `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	// 序列化的 peer.CollectionConfigPackage
+	Collections []byte `protobuf:"bytes,3,opt,name=collections,proto3" json:"collections,omitempty"`
+}
+
+func (x *ChaincodeQueryApprovedResponse) Reset() {
+	*x = ChaincodeQueryApprovedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeQueryApprovedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeQueryApprovedResponse) ProtoMessage() {}
+
+func (x *ChaincodeQueryApprovedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeQueryApprovedResponse.ProtoReflect.Descriptor instead.
+func (*ChaincodeQueryApprovedResponse) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ChaincodeQueryApprovedResponse) GetDefinition() *DefinitionArgs {
+	if x != nil {
+		return x.Definition
+	}
+	return nil
+}
+
+func (x *ChaincodeQueryApprovedResponse) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *ChaincodeQueryApprovedResponse) GetCollections() []byte {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+// 查询通道上已提交的链码定义，name 为空时查询所有链码
+type ChaincodeQueryCommittedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer    *Signer `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Peer      *Peer   `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	ChannelId string  `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name      string  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ChaincodeQueryCommittedRequest) Reset() {
+	*x = ChaincodeQueryCommittedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeQueryCommittedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeQueryCommittedRequest) ProtoMessage() {}
+
+func (x *ChaincodeQueryCommittedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeQueryCommittedRequest.ProtoReflect.Descriptor instead.
+func (*ChaincodeQueryCommittedRequest) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ChaincodeQueryCommittedRequest) GetSigner() *Signer {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *ChaincodeQueryCommittedRequest) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+func (x *ChaincodeQueryCommittedRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChaincodeQueryCommittedRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CommittedDefinition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Definition  *DefinitionArgs `protobuf:"bytes,1,opt,name=definition,proto3" json:"definition,omitempty"`
+	Collections []byte          `protobuf:"bytes,2,opt,name=collections,proto3" json:"collections,omitempty"`
+	// 查询单个链码时各组织对当前定义的授信情况
+	Approvals map[string]bool `protobuf:"bytes,3,rep,name=approvals,proto3" json:"approvals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *CommittedDefinition) Reset() {
+	*x = CommittedDefinition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommittedDefinition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommittedDefinition) ProtoMessage() {}
+
+func (x *CommittedDefinition) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommittedDefinition.ProtoReflect.Descriptor instead.
+func (*CommittedDefinition) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CommittedDefinition) GetDefinition() *DefinitionArgs {
+	if x != nil {
+		return x.Definition
+	}
+	return nil
+}
+
+func (x *CommittedDefinition) GetCollections() []byte {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *CommittedDefinition) GetApprovals() map[string]bool {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+type ChaincodeQueryCommittedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Definitions []*CommittedDefinition `protobuf:"bytes,1,rep,name=definitions,proto3" json:"definitions,omitempty"`
+}
+
+func (x *ChaincodeQueryCommittedResponse) Reset() {
+	*x = ChaincodeQueryCommittedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeQueryCommittedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeQueryCommittedResponse) ProtoMessage() {}
+
+func (x *ChaincodeQueryCommittedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeQueryCommittedResponse.ProtoReflect.Descriptor instead.
+func (*ChaincodeQueryCommittedResponse) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ChaincodeQueryCommittedResponse) GetDefinitions() []*CommittedDefinition {
+	if x != nil {
+		return x.Definitions
+	}
+	return nil
+}
+
+// 检查链码定义是否可以提交
+type ChaincodeCheckReadinessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer              *Signer         `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Peer                *Peer           `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	ChannelId           string          `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Definition          *DefinitionArgs `protobuf:"bytes,4,opt,name=definition,proto3" json:"definition,omitempty"`
+	SignaturePolicy     string          `protobuf:"bytes,5,opt,name=signature_policy,json=signaturePolicy,proto3" json:"signature_policy,omitempty"`
+	ChannelConfigPolicy string          `protobuf:"bytes,6,opt,name=channel_config_policy,json=channelConfigPolicy,proto3" json:"channel_config_policy,omitempty"`
+	CollectionConfig    []byte          `protobuf:"bytes,7,opt,name=collection_config,json=collectionConfig,proto3" json:"collection_config,omitempty"`
+}
+
+func (x *ChaincodeCheckReadinessRequest) Reset() {
+	*x = ChaincodeCheckReadinessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeCheckReadinessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeCheckReadinessRequest) ProtoMessage() {}
+
+func (x *ChaincodeCheckReadinessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeCheckReadinessRequest.ProtoReflect.Descriptor instead.
+func (*ChaincodeCheckReadinessRequest) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ChaincodeCheckReadinessRequest) GetSigner() *Signer {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *ChaincodeCheckReadinessRequest) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+func (x *ChaincodeCheckReadinessRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChaincodeCheckReadinessRequest) GetDefinition() *DefinitionArgs {
+	if x != nil {
+		return x.Definition
+	}
+	return nil
+}
+
+func (x *ChaincodeCheckReadinessRequest) GetSignaturePolicy() string {
+	if x != nil {
+		return x.SignaturePolicy
+	}
+	return ""
+}
+
+func (x *ChaincodeCheckReadinessRequest) GetChannelConfigPolicy() string {
+	if x != nil {
+		return x.ChannelConfigPolicy
+	}
+	return ""
+}
+
+func (x *ChaincodeCheckReadinessRequest) GetCollectionConfig() []byte {
+	if x != nil {
+		return x.CollectionConfig
+	}
+	return nil
+}
+
+type ChaincodeCheckReadinessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 组织的MSP ID 是否已授信该定义
+	Approvals map[string]bool `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *ChaincodeCheckReadinessResponse) Reset() {
+	*x = ChaincodeCheckReadinessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeCheckReadinessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeCheckReadinessResponse) ProtoMessage() {}
+
+func (x *ChaincodeCheckReadinessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeCheckReadinessResponse.ProtoReflect.Descriptor instead.
+func (*ChaincodeCheckReadinessResponse) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ChaincodeCheckReadinessResponse) GetApprovals() map[string]bool {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+// 查询链码在各组织的授信情况，每个组织使用自己的管理员和第一个节点
+type ChaincodeLifecycleStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orgs      []*DeployOrg `protobuf:"bytes,1,rep,name=orgs,proto3" json:"orgs,omitempty"`
+	ChannelId string       `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name      string       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ChaincodeLifecycleStatusRequest) Reset() {
+	*x = ChaincodeLifecycleStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeLifecycleStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeLifecycleStatusRequest) ProtoMessage() {}
+
+func (x *ChaincodeLifecycleStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeLifecycleStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChaincodeLifecycleStatusRequest) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ChaincodeLifecycleStatusRequest) GetOrgs() []*DeployOrg {
+	if x != nil {
+		return x.Orgs
+	}
+	return nil
+}
+
+func (x *ChaincodeLifecycleStatusRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ChaincodeLifecycleStatusRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type OrgApproval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MspId string `protobuf:"bytes,1,opt,name=msp_id,json=mspId,proto3" json:"msp_id,omitempty"`
+	// 组织最新授信的定义，组织未授信时为空
+	Approved  *DefinitionArgs `protobuf:"bytes,2,opt,name=approved,proto3" json:"approved,omitempty"`
+	PackageId string          `protobuf:"bytes,3,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	// 组织已授信已提交的定义
+	ApprovedCommitted bool `protobuf:"varint,4,opt,name=approved_committed,json=approvedCommitted,proto3" json:"approved_committed,omitempty"`
+	// 查询失败的原因
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *OrgApproval) Reset() {
+	*x = OrgApproval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrgApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgApproval) ProtoMessage() {}
+
+func (x *OrgApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgApproval.ProtoReflect.Descriptor instead.
+func (*OrgApproval) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OrgApproval) GetMspId() string {
+	if x != nil {
+		return x.MspId
+	}
+	return ""
+}
+
+func (x *OrgApproval) GetApproved() *DefinitionArgs {
+	if x != nil {
+		return x.Approved
+	}
+	return nil
+}
+
+func (x *OrgApproval) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *OrgApproval) GetApprovedCommitted() bool {
+	if x != nil {
+		return x.ApprovedCommitted
+	}
+	return false
+}
+
+func (x *OrgApproval) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ChaincodeLifecycleStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 已提交的定义，未提交时为空
+	Committed *CommittedDefinition `protobuf:"bytes,1,opt,name=committed,proto3" json:"committed,omitempty"`
+	Orgs      []*OrgApproval       `protobuf:"bytes,2,rep,name=orgs,proto3" json:"orgs,omitempty"`
+}
+
+func (x *ChaincodeLifecycleStatusResponse) Reset() {
+	*x = ChaincodeLifecycleStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeLifecycleStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeLifecycleStatusResponse) ProtoMessage() {}
+
+func (x *ChaincodeLifecycleStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeLifecycleStatusResponse.ProtoReflect.Descriptor instead.
+func (*ChaincodeLifecycleStatusResponse) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ChaincodeLifecycleStatusResponse) GetCommitted() *CommittedDefinition {
+	if x != nil {
+		return x.Committed
+	}
+	return nil
+}
+
+func (x *ChaincodeLifecycleStatusResponse) GetOrgs() []*OrgApproval {
+	if x != nil {
+		return x.Orgs
+	}
+	return nil
+}
+
+type ChaincodeArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Args    [][]byte `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+}
+
+func (x *ChaincodeArgs) Reset() {
+	*x = ChaincodeArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeArgs) ProtoMessage() {}
+
+func (x *ChaincodeArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeArgs.ProtoReflect.Descriptor instead.
+func (*ChaincodeArgs) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChaincodeArgs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChaincodeArgs) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ChaincodeArgs) GetArgs() [][]byte {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type ContractInvokeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer    *Signer        `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Endorsers []*Peer        `protobuf:"bytes,2,rep,name=endorsers,proto3" json:"endorsers,omitempty"`
+	Committer *Peer          `protobuf:"bytes,3,opt,name=committer,proto3" json:"committer,omitempty"`
+	Orderer   *Orderer       `protobuf:"bytes,4,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	Args      *ChaincodeArgs `protobuf:"bytes,5,opt,name=args,proto3" json:"args,omitempty"`
+	ChannelId string         `protobuf:"bytes,6,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *ContractInvokeRequest) Reset() {
+	*x = ContractInvokeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractInvokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractInvokeRequest) ProtoMessage() {}
+
+func (x *ContractInvokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractInvokeRequest.ProtoReflect.Descriptor instead.
+func (*ContractInvokeRequest) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ContractInvokeRequest) GetSigner() *Signer {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *ContractInvokeRequest) GetEndorsers() []*Peer {
+	if x != nil {
+		return x.Endorsers
+	}
+	return nil
+}
+
+func (x *ContractInvokeRequest) GetCommitter() *Peer {
+	if x != nil {
+		return x.Committer
+	}
+	return nil
+}
+
+func (x *ContractInvokeRequest) GetOrderer() *Orderer {
+	if x != nil {
+		return x.Orderer
+	}
+	return nil
+}
+
+func (x *ContractInvokeRequest) GetArgs() *ChaincodeArgs {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ContractInvokeRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+type ContractQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer    *Signer        `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Committer *Peer          `protobuf:"bytes,2,opt,name=committer,proto3" json:"committer,omitempty"`
+	Orderer   *Orderer       `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	Args      *ChaincodeArgs `protobuf:"bytes,4,opt,name=args,proto3" json:"args,omitempty"`
+	ChannelId string         `protobuf:"bytes,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *ContractQueryRequest) Reset() {
+	*x = ContractQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractQueryRequest) ProtoMessage() {}
+
+func (x *ContractQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractQueryRequest.ProtoReflect.Descriptor instead.
+func (*ContractQueryRequest) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ContractQueryRequest) GetSigner() *Signer {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *ContractQueryRequest) GetCommitter() *Peer {
+	if x != nil {
+		return x.Committer
+	}
+	return nil
+}
+
+func (x *ContractQueryRequest) GetOrderer() *Orderer {
+	if x != nil {
+		return x.Orderer
+	}
+	return nil
+}
+
+func (x *ContractQueryRequest) GetArgs() *ChaincodeArgs {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ContractQueryRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+// 交易发送给排序节点后即返回
+type ContractSubmitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// 背书节点的响应
+	Status  int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *ContractSubmitResponse) Reset() {
+	*x = ContractSubmitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractSubmitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractSubmitResponse) ProtoMessage() {}
+
+func (x *ContractSubmitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractSubmitResponse.ProtoReflect.Descriptor instead.
+func (*ContractSubmitResponse) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ContractSubmitResponse) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *ContractSubmitResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ContractSubmitResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ContractSubmitResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// 等待交易提交
+type ContractCommitStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer    *Signer `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Peer      *Peer   `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
+	ChannelId string  `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	TxId      string  `protobuf:"bytes,4,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (x *ContractCommitStatusRequest) Reset() {
+	*x = ContractCommitStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractCommitStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractCommitStatusRequest) ProtoMessage() {}
+
+func (x *ContractCommitStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractCommitStatusRequest.ProtoReflect.Descriptor instead.
+func (*ContractCommitStatusRequest) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ContractCommitStatusRequest) GetSigner() *Signer {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *ContractCommitStatusRequest) GetPeer() *Peer {
+	if x != nil {
+		return x.Peer
+	}
+	return nil
+}
+
+func (x *ContractCommitStatusRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ContractCommitStatusRequest) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+type ContractCommitStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// 交易的验证结果，即 peer.TxValidationCode，0 为有效
+	ValidationCode int32  `protobuf:"varint,2,opt,name=validation_code,json=validationCode,proto3" json:"validation_code,omitempty"`
+	BlockNumber    uint64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// 无效交易的错误码，见 common.ErrorCode
+	ErrorCode ErrorCode `protobuf:"varint,4,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Message   string    `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ContractCommitStatusResponse) Reset() {
+	*x = ContractCommitStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractCommitStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractCommitStatusResponse) ProtoMessage() {}
+
+func (x *ContractCommitStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractCommitStatusResponse.ProtoReflect.Descriptor instead.
+func (*ContractCommitStatusResponse) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ContractCommitStatusResponse) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *ContractCommitStatusResponse) GetValidationCode() int32 {
+	if x != nil {
+		return x.ValidationCode
+	}
+	return 0
+}
+
+func (x *ContractCommitStatusResponse) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *ContractCommitStatusResponse) GetErrorCode() ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ErrorCode_NO_ERROR
+}
+
+func (x *ContractCommitStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ChaincodeInstallResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ChaincodeInstallResponse_Result) Reset() {
+	*x = ChaincodeInstallResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChaincodeInstallResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaincodeInstallResponse_Result) ProtoMessage() {}
+
+func (x *ChaincodeInstallResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaincodeInstallResponse_Result.ProtoReflect.Descriptor instead.
+func (*ChaincodeInstallResponse_Result) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *ChaincodeInstallResponse_Result) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ChaincodeInstallResponse_Result) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ChaincodeInstallResponse_Result) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 使用该链码包的通道上的链码
+type InstalledChaincode_Reference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version   string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *InstalledChaincode_Reference) Reset() {
+	*x = InstalledChaincode_Reference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaincode_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstalledChaincode_Reference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstalledChaincode_Reference) ProtoMessage() {}
+
+func (x *InstalledChaincode_Reference) ProtoReflect() protoreflect.Message {
+	mi := &file_chaincode_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstalledChaincode_Reference.ProtoReflect.Descriptor instead.
+func (*InstalledChaincode_Reference) Descriptor() ([]byte, []int) {
+	return file_chaincode_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *InstalledChaincode_Reference) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *InstalledChaincode_Reference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InstalledChaincode_Reference) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+var File_chaincode_proto protoreflect.FileDescriptor
+
+var file_chaincode_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x1a, 0x0c, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd9, 0x01, 0x0a, 0x09, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x61, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x08,
+	0x67, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x07, 0x67, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x1d, 0x0a, 0x09, 0x70, 0x6b, 0x67,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08,
+	0x70, 0x6b, 0x67, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x69, 0x74, 0x52, 0x65, 0x66, 0x42, 0x09, 0x0a, 0x07, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x22, 0xee, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x3d, 0x0a, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x67,
+	0x0a, 0x0d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x16, 0x0a, 0x12, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f,
+	0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x52, 0x4f, 0x4d, 0x5f,
+	0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x14,
+	0x0a, 0x10, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x47, 0x49, 0x54,
+	0x5f, 0x52, 0x45, 0x50, 0x4f, 0x10, 0x03, 0x22, 0xf8, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x66, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x5f,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x73, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x22, 0xa0, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x91, 0x02, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x39, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e,
+	0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x52, 0x0a,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x16, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x09, 0x65,
+	0x6e, 0x64, 0x6f, 0x72, 0x73, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67,
+	0x73, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf9, 0x01,
+	0x0a, 0x18, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x4a, 0x0a,
+	0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x09, 0x44, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x4f, 0x72, 0x67, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x22,
+	0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x22, 0xd4, 0x03, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a,
+	0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6f, 0x72, 0x67, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x4f, 0x72, 0x67, 0x52, 0x04, 0x6f, 0x72,
+	0x67, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x52, 0x0a, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x69, 0x6e, 0x69, 0x74, 0x41, 0x72, 0x67, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x0a, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x38, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0c,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xb3, 0x01, 0x0a,
+	0x17, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x2e, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x22, 0x6a, 0x0a, 0x1e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x04,
+	0x70, 0x65, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x22, 0xec,
+	0x01, 0x0a, 0x12, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x47, 0x0a, 0x0a, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x1a, 0x58, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a,
+	0x1f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22,
+	0x85, 0x01, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x06,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x1b, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6b, 0x67, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x6b, 0x67, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x1d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a,
+	0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x9c,
+	0x01, 0x0a, 0x1e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73,
+	0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9d, 0x01,
+	0x0a, 0x1e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xfd, 0x01,
+	0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x72, 0x67, 0x73, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x1a,
+	0x3c, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x63, 0x0a,
+	0x1f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x40, 0x0a, 0x0b, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xd0, 0x02, 0x0a, 0x1e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x20, 0x0a,
+	0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x52, 0x0a, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0xb8, 0x01, 0x0a, 0x1f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x09, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x7e, 0x0a, 0x1f, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x66,
+	0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x6f, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x44, 0x65,
+	0x70, 0x6c, 0x6f, 0x79, 0x4f, 0x72, 0x67, 0x52, 0x04, 0x6f, 0x72, 0x67, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0xc3, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x12, 0x15, 0x0a, 0x06, 0x6d, 0x73, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6d, 0x73, 0x70, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x72, 0x67, 0x73, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a,
+	0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x20, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x6f, 0x72, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x2e, 0x4f, 0x72, 0x67, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52,
+	0x04, 0x6f, 0x72, 0x67, 0x73, 0x22, 0x51, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x8f, 0x02, 0x0a, 0x15, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x09, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x12, 0x2c, 0x0a,
+	0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x41, 0x72, 0x67, 0x73, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xe2, 0x01, 0x0a, 0x14, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x65, 0x72, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x72, 0x67, 0x73, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22,
+	0x79, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x9b, 0x01, 0x0a, 0x1b, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04,
+	0x70, 0x65, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0xcb, 0x01, 0x0a, 0x1c, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0xe5, 0x07, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x75, 0x62, 0x12, 0x5d, 0x0a, 0x10, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a,
+	0x0f, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61,
+	0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x0e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x29, 0x2e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x47, 0x65, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x0d,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x28, 0x2e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x0e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x29, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x6f, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x6c, 0x0a, 0x0f, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xc0,
+	0x02, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x74, 0x75, 0x62, 0x12,
+	0x3e, 0x0a, 0x06, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e,
+	0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3c, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a,
+	0x06, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x12, 0x20, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x76, 0x6f,
+	0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61,
+	0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26,
+	0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x42, 0x13, 0x5a, 0x11, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_chaincode_proto_rawDescOnce sync.Once
+	file_chaincode_proto_rawDescData = file_chaincode_proto_rawDesc
+)
+
+func file_chaincode_proto_rawDescGZIP() []byte {
+	file_chaincode_proto_rawDescOnce.Do(func() {
+		file_chaincode_proto_rawDescData = protoimpl.X.CompressGZIP(file_chaincode_proto_rawDescData)
+	})
+	return file_chaincode_proto_rawDescData
+}
+
+var file_chaincode_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_chaincode_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_chaincode_proto_goTypes = []interface{}{
+	(ChaincodePackage_ChaincodeMode)(0),      // 0: chaincode.ChaincodePackage.ChaincodeMode
+	(*Chaincode)(nil),                        // 1: chaincode.Chaincode
+	(*ChaincodePackage)(nil),                 // 2: chaincode.ChaincodePackage
+	(*DefinitionArgs)(nil),                   // 3: chaincode.DefinitionArgs
+	(*ChaincodeInstallRequest)(nil),          // 4: chaincode.ChaincodeInstallRequest
+	(*ChaincodeApproveRequest)(nil),          // 5: chaincode.ChaincodeApproveRequest
+	(*ChaincodeCommitRequest)(nil),           // 6: chaincode.ChaincodeCommitRequest
+	(*ChaincodeInstallResponse)(nil),         // 7: chaincode.ChaincodeInstallResponse
+	(*DeployOrg)(nil),                        // 8: chaincode.DeployOrg
+	(*ChaincodeDeployRequest)(nil),           // 9: chaincode.ChaincodeDeployRequest
+	(*DeployStep)(nil),                       // 10: chaincode.DeployStep
+	(*ChaincodeDeployResponse)(nil),          // 11: chaincode.ChaincodeDeployResponse
+	(*ChaincodeQueryInstalledRequest)(nil),   // 12: chaincode.ChaincodeQueryInstalledRequest
+	(*InstalledChaincode)(nil),               // 13: chaincode.InstalledChaincode
+	(*ChaincodeQueryInstalledResponse)(nil),  // 14: chaincode.ChaincodeQueryInstalledResponse
+	(*ChaincodeGetPackageRequest)(nil),       // 15: chaincode.ChaincodeGetPackageRequest
+	(*ChaincodeGetPackageResponse)(nil),      // 16: chaincode.ChaincodeGetPackageResponse
+	(*ChaincodeQueryApprovedRequest)(nil),    // 17: chaincode.ChaincodeQueryApprovedRequest
+	(*ChaincodeQueryApprovedResponse)(nil),   // 18: chaincode.ChaincodeQueryApprovedResponse
+	(*ChaincodeQueryCommittedRequest)(nil),   // 19: chaincode.ChaincodeQueryCommittedRequest
+	(*CommittedDefinition)(nil),              // 20: chaincode.CommittedDefinition
+	(*ChaincodeQueryCommittedResponse)(nil),  // 21: chaincode.ChaincodeQueryCommittedResponse
+	(*ChaincodeCheckReadinessRequest)(nil),   // 22: chaincode.ChaincodeCheckReadinessRequest
+	(*ChaincodeCheckReadinessResponse)(nil),  // 23: chaincode.ChaincodeCheckReadinessResponse
+	(*ChaincodeLifecycleStatusRequest)(nil),  // 24: chaincode.ChaincodeLifecycleStatusRequest
+	(*OrgApproval)(nil),                      // 25: chaincode.OrgApproval
+	(*ChaincodeLifecycleStatusResponse)(nil), // 26: chaincode.ChaincodeLifecycleStatusResponse
+	(*ChaincodeArgs)(nil),                    // 27: chaincode.ChaincodeArgs
+	(*ContractInvokeRequest)(nil),            // 28: chaincode.ContractInvokeRequest
+	(*ContractQueryRequest)(nil),             // 29: chaincode.ContractQueryRequest
+	(*ContractSubmitResponse)(nil),           // 30: chaincode.ContractSubmitResponse
+	(*ContractCommitStatusRequest)(nil),      // 31: chaincode.ContractCommitStatusRequest
+	(*ContractCommitStatusResponse)(nil),     // 32: chaincode.ContractCommitStatusResponse
+	(*ChaincodeInstallResponse_Result)(nil),  // 33: chaincode.ChaincodeInstallResponse.Result
+	(*InstalledChaincode_Reference)(nil),     // 34: chaincode.InstalledChaincode.Reference
+	nil,                                      // 35: chaincode.CommittedDefinition.ApprovalsEntry
+	nil,                                      // 36: chaincode.ChaincodeCheckReadinessResponse.ApprovalsEntry
+	(*Signer)(nil),                           // 37: common.Signer
+	(*Peer)(nil),                             // 38: common.Peer
+	(*Orderer)(nil),                          // 39: common.Orderer
+	(ErrorCode)(0),                           // 40: common.ErrorCode
+	(*ErrorDetail)(nil),                      // 41: common.ErrorDetail
+	(*Response)(nil),                         // 42: common.Response
+}
+var file_chaincode_proto_depIdxs = []int32{
+	0,  // 0: chaincode.ChaincodePackage.mode:type_name -> chaincode.ChaincodePackage.ChaincodeMode
+	1,  // 1: chaincode.ChaincodePackage.chaincode:type_name -> chaincode.Chaincode
+	37, // 2: chaincode.ChaincodeInstallRequest.signer:type_name -> common.Signer
+	38, // 3: chaincode.ChaincodeInstallRequest.peers:type_name -> common.Peer
+	2,  // 4: chaincode.ChaincodeInstallRequest.chaincode:type_name -> chaincode.ChaincodePackage
+	37, // 5: chaincode.ChaincodeApproveRequest.signer:type_name -> common.Signer
+	38, // 6: chaincode.ChaincodeApproveRequest.committer:type_name -> common.Peer
+	39, // 7: chaincode.ChaincodeApproveRequest.orderer:type_name -> common.Orderer
+	3,  // 8: chaincode.ChaincodeApproveRequest.definition:type_name -> chaincode.DefinitionArgs
+	37, // 9: chaincode.ChaincodeCommitRequest.signer:type_name -> common.Signer
+	38, // 10: chaincode.ChaincodeCommitRequest.endorsers:type_name -> common.Peer
+	39, // 11: chaincode.ChaincodeCommitRequest.orderer:type_name -> common.Orderer
+	3,  // 12: chaincode.ChaincodeCommitRequest.definition:type_name -> chaincode.DefinitionArgs
+	33, // 13: chaincode.ChaincodeInstallResponse.results:type_name -> chaincode.ChaincodeInstallResponse.Result
+	37, // 14: chaincode.DeployOrg.signer:type_name -> common.Signer
+	38, // 15: chaincode.DeployOrg.peers:type_name -> common.Peer
+	2,  // 16: chaincode.ChaincodeDeployRequest.chaincode:type_name -> chaincode.ChaincodePackage
+	8,  // 17: chaincode.ChaincodeDeployRequest.orgs:type_name -> chaincode.DeployOrg
+	39, // 18: chaincode.ChaincodeDeployRequest.orderer:type_name -> common.Orderer
+	3,  // 19: chaincode.ChaincodeDeployRequest.definition:type_name -> chaincode.DefinitionArgs
+	40, // 20: chaincode.DeployStep.error_code:type_name -> common.ErrorCode
+	41, // 21: chaincode.DeployStep.error_details:type_name -> common.ErrorDetail
+	10, // 22: chaincode.ChaincodeDeployResponse.steps:type_name -> chaincode.DeployStep
+	37, // 23: chaincode.ChaincodeQueryInstalledRequest.signer:type_name -> common.Signer
+	38, // 24: chaincode.ChaincodeQueryInstalledRequest.peer:type_name -> common.Peer
+	34, // 25: chaincode.InstalledChaincode.references:type_name -> chaincode.InstalledChaincode.Reference
+	13, // 26: chaincode.ChaincodeQueryInstalledResponse.chaincodes:type_name -> chaincode.InstalledChaincode
+	37, // 27: chaincode.ChaincodeGetPackageRequest.signer:type_name -> common.Signer
+	38, // 28: chaincode.ChaincodeGetPackageRequest.peer:type_name -> common.Peer
+	37, // 29: chaincode.ChaincodeQueryApprovedRequest.signer:type_name -> common.Signer
+	38, // 30: chaincode.ChaincodeQueryApprovedRequest.peer:type_name -> common.Peer
+	3,  // 31: chaincode.ChaincodeQueryApprovedResponse.definition:type_name -> chaincode.DefinitionArgs
+	37, // 32: chaincode.ChaincodeQueryCommittedRequest.signer:type_name -> common.Signer
+	38, // 33: chaincode.ChaincodeQueryCommittedRequest.peer:type_name -> common.Peer
+	3,  // 34: chaincode.CommittedDefinition.definition:type_name -> chaincode.DefinitionArgs
+	35, // 35: chaincode.CommittedDefinition.approvals:type_name -> chaincode.CommittedDefinition.ApprovalsEntry
+	20, // 36: chaincode.ChaincodeQueryCommittedResponse.definitions:type_name -> chaincode.CommittedDefinition
+	37, // 37: chaincode.ChaincodeCheckReadinessRequest.signer:type_name -> common.Signer
+	38, // 38: chaincode.ChaincodeCheckReadinessRequest.peer:type_name -> common.Peer
+	3,  // 39: chaincode.ChaincodeCheckReadinessRequest.definition:type_name -> chaincode.DefinitionArgs
+	36, // 40: chaincode.ChaincodeCheckReadinessResponse.approvals:type_name -> chaincode.ChaincodeCheckReadinessResponse.ApprovalsEntry
+	8,  // 41: chaincode.ChaincodeLifecycleStatusRequest.orgs:type_name -> chaincode.DeployOrg
+	3,  // 42: chaincode.OrgApproval.approved:type_name -> chaincode.DefinitionArgs
+	20, // 43: chaincode.ChaincodeLifecycleStatusResponse.committed:type_name -> chaincode.CommittedDefinition
+	25, // 44: chaincode.ChaincodeLifecycleStatusResponse.orgs:type_name -> chaincode.OrgApproval
+	37, // 45: chaincode.ContractInvokeRequest.signer:type_name -> common.Signer
+	38, // 46: chaincode.ContractInvokeRequest.endorsers:type_name -> common.Peer
+	38, // 47: chaincode.ContractInvokeRequest.committer:type_name -> common.Peer
+	39, // 48: chaincode.ContractInvokeRequest.orderer:type_name -> common.Orderer
+	27, // 49: chaincode.ContractInvokeRequest.args:type_name -> chaincode.ChaincodeArgs
+	37, // 50: chaincode.ContractQueryRequest.signer:type_name -> common.Signer
+	38, // 51: chaincode.ContractQueryRequest.committer:type_name -> common.Peer
+	39, // 52: chaincode.ContractQueryRequest.orderer:type_name -> common.Orderer
+	27, // 53: chaincode.ContractQueryRequest.args:type_name -> chaincode.ChaincodeArgs
+	37, // 54: chaincode.ContractCommitStatusRequest.signer:type_name -> common.Signer
+	38, // 55: chaincode.ContractCommitStatusRequest.peer:type_name -> common.Peer
+	40, // 56: chaincode.ContractCommitStatusResponse.error_code:type_name -> common.ErrorCode
+	4,  // 57: chaincode.ChaincodeStub.InstallChaincode:input_type -> chaincode.ChaincodeInstallRequest
+	5,  // 58: chaincode.ChaincodeStub.ApproveChaincode:input_type -> chaincode.ChaincodeApproveRequest
+	6,  // 59: chaincode.ChaincodeStub.CommitChaincode:input_type -> chaincode.ChaincodeCommitRequest
+	9,  // 60: chaincode.ChaincodeStub.DeployChaincode:input_type -> chaincode.ChaincodeDeployRequest
+	12, // 61: chaincode.ChaincodeStub.QueryInstalled:input_type -> chaincode.ChaincodeQueryInstalledRequest
+	15, // 62: chaincode.ChaincodeStub.GetInstalledPackage:input_type -> chaincode.ChaincodeGetPackageRequest
+	17, // 63: chaincode.ChaincodeStub.QueryApproved:input_type -> chaincode.ChaincodeQueryApprovedRequest
+	19, // 64: chaincode.ChaincodeStub.QueryCommitted:input_type -> chaincode.ChaincodeQueryCommittedRequest
+	22, // 65: chaincode.ChaincodeStub.CheckCommitReadiness:input_type -> chaincode.ChaincodeCheckReadinessRequest
+	24, // 66: chaincode.ChaincodeStub.LifecycleStatus:input_type -> chaincode.ChaincodeLifecycleStatusRequest
+	28, // 67: chaincode.ContractStub.Invoke:input_type -> chaincode.ContractInvokeRequest
+	29, // 68: chaincode.ContractStub.Query:input_type -> chaincode.ContractQueryRequest
+	28, // 69: chaincode.ContractStub.Submit:input_type -> chaincode.ContractInvokeRequest
+	31, // 70: chaincode.ContractStub.CommitStatus:input_type -> chaincode.ContractCommitStatusRequest
+	7,  // 71: chaincode.ChaincodeStub.InstallChaincode:output_type -> chaincode.ChaincodeInstallResponse
+	42, // 72: chaincode.ChaincodeStub.ApproveChaincode:output_type -> common.Response
+	42, // 73: chaincode.ChaincodeStub.CommitChaincode:output_type -> common.Response
+	11, // 74: chaincode.ChaincodeStub.DeployChaincode:output_type -> chaincode.ChaincodeDeployResponse
+	14, // 75: chaincode.ChaincodeStub.QueryInstalled:output_type -> chaincode.ChaincodeQueryInstalledResponse
+	16, // 76: chaincode.ChaincodeStub.GetInstalledPackage:output_type -> chaincode.ChaincodeGetPackageResponse
+	18, // 77: chaincode.ChaincodeStub.QueryApproved:output_type -> chaincode.ChaincodeQueryApprovedResponse
+	21, // 78: chaincode.ChaincodeStub.QueryCommitted:output_type -> chaincode.ChaincodeQueryCommittedResponse
+	23, // 79: chaincode.ChaincodeStub.CheckCommitReadiness:output_type -> chaincode.ChaincodeCheckReadinessResponse
+	26, // 80: chaincode.ChaincodeStub.LifecycleStatus:output_type -> chaincode.ChaincodeLifecycleStatusResponse
+	42, // 81: chaincode.ContractStub.Invoke:output_type -> common.Response
+	42, // 82: chaincode.ContractStub.Query:output_type -> common.Response
+	30, // 83: chaincode.ContractStub.Submit:output_type -> chaincode.ContractSubmitResponse
+	32, // 84: chaincode.ContractStub.CommitStatus:output_type -> chaincode.ContractCommitStatusResponse
+	71, // [71:85] is the sub-list for method output_type
+	57, // [57:71] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
+}
+
+func init() { file_chaincode_proto_init() }
+func file_chaincode_proto_init() {
+	if File_chaincode_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_chaincode_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chaincode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1275,7 +3286,7 @@ func file_chaincode_proto_init() {
 			}
 		}
 		file_chaincode_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChaincodeArgs); i {
+			switch v := v.(*DeployOrg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1287,7 +3298,7 @@ func file_chaincode_proto_init() {
 			}
 		}
 		file_chaincode_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContractInvokeRequest); i {
+			switch v := v.(*ChaincodeDeployRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1299,7 +3310,7 @@ func file_chaincode_proto_init() {
 			}
 		}
 		file_chaincode_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContractQueryRequest); i {
+			switch v := v.(*DeployStep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1311,6 +3322,270 @@ func file_chaincode_proto_init() {
 			}
 		}
 		file_chaincode_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeDeployResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeQueryInstalledRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstalledChaincode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeQueryInstalledResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeGetPackageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeGetPackageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeQueryApprovedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeQueryApprovedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeQueryCommittedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommittedDefinition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeQueryCommittedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeCheckReadinessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeCheckReadinessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeLifecycleStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrgApproval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeLifecycleStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChaincodeArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractInvokeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractSubmitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractCommitStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractCommitStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaincode_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChaincodeInstallResponse_Result); i {
 			case 0:
 				return &v.state
@@ -1322,6 +3597,18 @@ func file_chaincode_proto_init() {
 				return nil
 			}
 		}
+		file_chaincode_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstalledChaincode_Reference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_chaincode_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Chaincode_Source)(nil),
@@ -1335,7 +3622,7 @@ func file_chaincode_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chaincode_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -0,0 +1,623 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package protoutil
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ChaincodeStubClient is the client API for ChaincodeStub service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChaincodeStubClient interface {
+	InstallChaincode(ctx context.Context, in *ChaincodeInstallRequest, opts ...grpc.CallOption) (*ChaincodeInstallResponse, error)
+	ApproveChaincode(ctx context.Context, in *ChaincodeApproveRequest, opts ...grpc.CallOption) (*Response, error)
+	CommitChaincode(ctx context.Context, in *ChaincodeCommitRequest, opts ...grpc.CallOption) (*Response, error)
+	DeployChaincode(ctx context.Context, in *ChaincodeDeployRequest, opts ...grpc.CallOption) (*ChaincodeDeployResponse, error)
+	QueryInstalled(ctx context.Context, in *ChaincodeQueryInstalledRequest, opts ...grpc.CallOption) (*ChaincodeQueryInstalledResponse, error)
+	GetInstalledPackage(ctx context.Context, in *ChaincodeGetPackageRequest, opts ...grpc.CallOption) (*ChaincodeGetPackageResponse, error)
+	QueryApproved(ctx context.Context, in *ChaincodeQueryApprovedRequest, opts ...grpc.CallOption) (*ChaincodeQueryApprovedResponse, error)
+	QueryCommitted(ctx context.Context, in *ChaincodeQueryCommittedRequest, opts ...grpc.CallOption) (*ChaincodeQueryCommittedResponse, error)
+	CheckCommitReadiness(ctx context.Context, in *ChaincodeCheckReadinessRequest, opts ...grpc.CallOption) (*ChaincodeCheckReadinessResponse, error)
+	LifecycleStatus(ctx context.Context, in *ChaincodeLifecycleStatusRequest, opts ...grpc.CallOption) (*ChaincodeLifecycleStatusResponse, error)
+}
+
+type chaincodeStubClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChaincodeStubClient(cc grpc.ClientConnInterface) ChaincodeStubClient {
+	return &chaincodeStubClient{cc}
+}
+
+func (c *chaincodeStubClient) InstallChaincode(ctx context.Context, in *ChaincodeInstallRequest, opts ...grpc.CallOption) (*ChaincodeInstallResponse, error) {
+	out := new(ChaincodeInstallResponse)
+	err := c.cc.Invoke(ctx, "/chaincode.ChaincodeStub/InstallChaincode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaincodeStubClient) ApproveChaincode(ctx context.Context, in *ChaincodeApproveRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/chaincode.ChaincodeStub/ApproveChaincode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaincodeStubClient) CommitChaincode(ctx context.Context, in *ChaincodeCommitRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/chaincode.ChaincodeStub/CommitChaincode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaincodeStubClient) DeployChaincode(ctx context.Context, in *ChaincodeDeployRequest, opts ...grpc.CallOption) (*ChaincodeDeployResponse, error) {
+	out := new(ChaincodeDeployResponse)
+	err := c.cc.Invoke(ctx, "/chaincode.ChaincodeStub/DeployChaincode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaincodeStubClient) QueryInstalled(ctx context.Context, in *ChaincodeQueryInstalledRequest, opts ...grpc.CallOption) (*ChaincodeQueryInstalledResponse, error) {
+	out := new(ChaincodeQueryInstalledResponse)
+	err := c.cc.Invoke(ctx, "/chaincode.ChaincodeStub/QueryInstalled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaincodeStubClient) GetInstalledPackage(ctx context.Context, in *ChaincodeGetPackageRequest, opts ...grpc.CallOption) (*ChaincodeGetPackageResponse, error) {
+	out := new(ChaincodeGetPackageResponse)
+	err := c.cc.Invoke(ctx, "/chaincode.ChaincodeStub/GetInstalledPackage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaincodeStubClient) QueryApproved(ctx context.Context, in *ChaincodeQueryApprovedRequest, opts ...grpc.CallOption) (*ChaincodeQueryApprovedResponse, error) {
+	out := new(ChaincodeQueryApprovedResponse)
+	err := c.cc.Invoke(ctx, "/chaincode.ChaincodeStub/QueryApproved", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaincodeStubClient) QueryCommitted(ctx context.Context, in *ChaincodeQueryCommittedRequest, opts ...grpc.CallOption) (*ChaincodeQueryCommittedResponse, error) {
+	out := new(ChaincodeQueryCommittedResponse)
+	err := c.cc.Invoke(ctx, "/chaincode.ChaincodeStub/QueryCommitted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaincodeStubClient) CheckCommitReadiness(ctx context.Context, in *ChaincodeCheckReadinessRequest, opts ...grpc.CallOption) (*ChaincodeCheckReadinessResponse, error) {
+	out := new(ChaincodeCheckReadinessResponse)
+	err := c.cc.Invoke(ctx, "/chaincode.ChaincodeStub/CheckCommitReadiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaincodeStubClient) LifecycleStatus(ctx context.Context, in *ChaincodeLifecycleStatusRequest, opts ...grpc.CallOption) (*ChaincodeLifecycleStatusResponse, error) {
+	out := new(ChaincodeLifecycleStatusResponse)
+	err := c.cc.Invoke(ctx, "/chaincode.ChaincodeStub/LifecycleStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChaincodeStubServer is the server API for ChaincodeStub service.
+// All implementations must embed UnimplementedChaincodeStubServer
+// for forward compatibility
+type ChaincodeStubServer interface {
+	InstallChaincode(context.Context, *ChaincodeInstallRequest) (*ChaincodeInstallResponse, error)
+	ApproveChaincode(context.Context, *ChaincodeApproveRequest) (*Response, error)
+	CommitChaincode(context.Context, *ChaincodeCommitRequest) (*Response, error)
+	DeployChaincode(context.Context, *ChaincodeDeployRequest) (*ChaincodeDeployResponse, error)
+	QueryInstalled(context.Context, *ChaincodeQueryInstalledRequest) (*ChaincodeQueryInstalledResponse, error)
+	GetInstalledPackage(context.Context, *ChaincodeGetPackageRequest) (*ChaincodeGetPackageResponse, error)
+	QueryApproved(context.Context, *ChaincodeQueryApprovedRequest) (*ChaincodeQueryApprovedResponse, error)
+	QueryCommitted(context.Context, *ChaincodeQueryCommittedRequest) (*ChaincodeQueryCommittedResponse, error)
+	CheckCommitReadiness(context.Context, *ChaincodeCheckReadinessRequest) (*ChaincodeCheckReadinessResponse, error)
+	LifecycleStatus(context.Context, *ChaincodeLifecycleStatusRequest) (*ChaincodeLifecycleStatusResponse, error)
+	mustEmbedUnimplementedChaincodeStubServer()
+}
+
+// UnimplementedChaincodeStubServer must be embedded to have forward compatible implementations.
+type UnimplementedChaincodeStubServer struct {
+}
+
+func (UnimplementedChaincodeStubServer) InstallChaincode(context.Context, *ChaincodeInstallRequest) (*ChaincodeInstallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallChaincode not implemented")
+}
+func (UnimplementedChaincodeStubServer) ApproveChaincode(context.Context, *ChaincodeApproveRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveChaincode not implemented")
+}
+func (UnimplementedChaincodeStubServer) CommitChaincode(context.Context, *ChaincodeCommitRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitChaincode not implemented")
+}
+func (UnimplementedChaincodeStubServer) DeployChaincode(context.Context, *ChaincodeDeployRequest) (*ChaincodeDeployResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeployChaincode not implemented")
+}
+func (UnimplementedChaincodeStubServer) QueryInstalled(context.Context, *ChaincodeQueryInstalledRequest) (*ChaincodeQueryInstalledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryInstalled not implemented")
+}
+func (UnimplementedChaincodeStubServer) GetInstalledPackage(context.Context, *ChaincodeGetPackageRequest) (*ChaincodeGetPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledPackage not implemented")
+}
+func (UnimplementedChaincodeStubServer) QueryApproved(context.Context, *ChaincodeQueryApprovedRequest) (*ChaincodeQueryApprovedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryApproved not implemented")
+}
+func (UnimplementedChaincodeStubServer) QueryCommitted(context.Context, *ChaincodeQueryCommittedRequest) (*ChaincodeQueryCommittedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCommitted not implemented")
+}
+func (UnimplementedChaincodeStubServer) CheckCommitReadiness(context.Context, *ChaincodeCheckReadinessRequest) (*ChaincodeCheckReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCommitReadiness not implemented")
+}
+func (UnimplementedChaincodeStubServer) LifecycleStatus(context.Context, *ChaincodeLifecycleStatusRequest) (*ChaincodeLifecycleStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LifecycleStatus not implemented")
+}
+func (UnimplementedChaincodeStubServer) mustEmbedUnimplementedChaincodeStubServer() {}
+
+// UnsafeChaincodeStubServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChaincodeStubServer will
+// result in compilation errors.
+type UnsafeChaincodeStubServer interface {
+	mustEmbedUnimplementedChaincodeStubServer()
+}
+
+func RegisterChaincodeStubServer(s grpc.ServiceRegistrar, srv ChaincodeStubServer) {
+	s.RegisterService(&ChaincodeStub_ServiceDesc, srv)
+}
+
+func _ChaincodeStub_InstallChaincode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeInstallRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeStubServer).InstallChaincode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaincode.ChaincodeStub/InstallChaincode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeStubServer).InstallChaincode(ctx, req.(*ChaincodeInstallRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaincodeStub_ApproveChaincode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeApproveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeStubServer).ApproveChaincode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaincode.ChaincodeStub/ApproveChaincode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeStubServer).ApproveChaincode(ctx, req.(*ChaincodeApproveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaincodeStub_CommitChaincode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeStubServer).CommitChaincode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaincode.ChaincodeStub/CommitChaincode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeStubServer).CommitChaincode(ctx, req.(*ChaincodeCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaincodeStub_DeployChaincode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeDeployRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeStubServer).DeployChaincode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaincode.ChaincodeStub/DeployChaincode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeStubServer).DeployChaincode(ctx, req.(*ChaincodeDeployRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaincodeStub_QueryInstalled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeQueryInstalledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeStubServer).QueryInstalled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaincode.ChaincodeStub/QueryInstalled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeStubServer).QueryInstalled(ctx, req.(*ChaincodeQueryInstalledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaincodeStub_GetInstalledPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeGetPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeStubServer).GetInstalledPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaincode.ChaincodeStub/GetInstalledPackage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeStubServer).GetInstalledPackage(ctx, req.(*ChaincodeGetPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaincodeStub_QueryApproved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeQueryApprovedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeStubServer).QueryApproved(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaincode.ChaincodeStub/QueryApproved",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeStubServer).QueryApproved(ctx, req.(*ChaincodeQueryApprovedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaincodeStub_QueryCommitted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeQueryCommittedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeStubServer).QueryCommitted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaincode.ChaincodeStub/QueryCommitted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeStubServer).QueryCommitted(ctx, req.(*ChaincodeQueryCommittedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaincodeStub_CheckCommitReadiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeCheckReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeStubServer).CheckCommitReadiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaincode.ChaincodeStub/CheckCommitReadiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeStubServer).CheckCommitReadiness(ctx, req.(*ChaincodeCheckReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaincodeStub_LifecycleStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaincodeLifecycleStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaincodeStubServer).LifecycleStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaincode.ChaincodeStub/LifecycleStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaincodeStubServer).LifecycleStatus(ctx, req.(*ChaincodeLifecycleStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChaincodeStub_ServiceDesc is the grpc.ServiceDesc for ChaincodeStub service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChaincodeStub_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "chaincode.ChaincodeStub",
+	HandlerType: (*ChaincodeStubServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InstallChaincode",
+			Handler:    _ChaincodeStub_InstallChaincode_Handler,
+		},
+		{
+			MethodName: "ApproveChaincode",
+			Handler:    _ChaincodeStub_ApproveChaincode_Handler,
+		},
+		{
+			MethodName: "CommitChaincode",
+			Handler:    _ChaincodeStub_CommitChaincode_Handler,
+		},
+		{
+			MethodName: "DeployChaincode",
+			Handler:    _ChaincodeStub_DeployChaincode_Handler,
+		},
+		{
+			MethodName: "QueryInstalled",
+			Handler:    _ChaincodeStub_QueryInstalled_Handler,
+		},
+		{
+			MethodName: "GetInstalledPackage",
+			Handler:    _ChaincodeStub_GetInstalledPackage_Handler,
+		},
+		{
+			MethodName: "QueryApproved",
+			Handler:    _ChaincodeStub_QueryApproved_Handler,
+		},
+		{
+			MethodName: "QueryCommitted",
+			Handler:    _ChaincodeStub_QueryCommitted_Handler,
+		},
+		{
+			MethodName: "CheckCommitReadiness",
+			Handler:    _ChaincodeStub_CheckCommitReadiness_Handler,
+		},
+		{
+			MethodName: "LifecycleStatus",
+			Handler:    _ChaincodeStub_LifecycleStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chaincode.proto",
+}
+
+// ContractStubClient is the client API for ContractStub service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ContractStubClient interface {
+	Invoke(ctx context.Context, in *ContractInvokeRequest, opts ...grpc.CallOption) (*Response, error)
+	Query(ctx context.Context, in *ContractQueryRequest, opts ...grpc.CallOption) (*Response, error)
+	// 背书后把交易发送给排序节点即返回，不等待交易提交
+	Submit(ctx context.Context, in *ContractInvokeRequest, opts ...grpc.CallOption) (*ContractSubmitResponse, error)
+	// 等待交易提交，交易已提交时直接返回验证结果和所在区块
+	CommitStatus(ctx context.Context, in *ContractCommitStatusRequest, opts ...grpc.CallOption) (*ContractCommitStatusResponse, error)
+}
+
+type contractStubClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewContractStubClient(cc grpc.ClientConnInterface) ContractStubClient {
+	return &contractStubClient{cc}
+}
+
+func (c *contractStubClient) Invoke(ctx context.Context, in *ContractInvokeRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/chaincode.ContractStub/Invoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contractStubClient) Query(ctx context.Context, in *ContractQueryRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/chaincode.ContractStub/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contractStubClient) Submit(ctx context.Context, in *ContractInvokeRequest, opts ...grpc.CallOption) (*ContractSubmitResponse, error) {
+	out := new(ContractSubmitResponse)
+	err := c.cc.Invoke(ctx, "/chaincode.ContractStub/Submit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contractStubClient) CommitStatus(ctx context.Context, in *ContractCommitStatusRequest, opts ...grpc.CallOption) (*ContractCommitStatusResponse, error) {
+	out := new(ContractCommitStatusResponse)
+	err := c.cc.Invoke(ctx, "/chaincode.ContractStub/CommitStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ContractStubServer is the server API for ContractStub service.
+// All implementations must embed UnimplementedContractStubServer
+// for forward compatibility
+type ContractStubServer interface {
+	Invoke(context.Context, *ContractInvokeRequest) (*Response, error)
+	Query(context.Context, *ContractQueryRequest) (*Response, error)
+	// 背书后把交易发送给排序节点即返回，不等待交易提交
+	Submit(context.Context, *ContractInvokeRequest) (*ContractSubmitResponse, error)
+	// 等待交易提交，交易已提交时直接返回验证结果和所在区块
+	CommitStatus(context.Context, *ContractCommitStatusRequest) (*ContractCommitStatusResponse, error)
+	mustEmbedUnimplementedContractStubServer()
+}
+
+// UnimplementedContractStubServer must be embedded to have forward compatible implementations.
+type UnimplementedContractStubServer struct {
+}
+
+func (UnimplementedContractStubServer) Invoke(context.Context, *ContractInvokeRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Invoke not implemented")
+}
+func (UnimplementedContractStubServer) Query(context.Context, *ContractQueryRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (UnimplementedContractStubServer) Submit(context.Context, *ContractInvokeRequest) (*ContractSubmitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Submit not implemented")
+}
+func (UnimplementedContractStubServer) CommitStatus(context.Context, *ContractCommitStatusRequest) (*ContractCommitStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitStatus not implemented")
+}
+func (UnimplementedContractStubServer) mustEmbedUnimplementedContractStubServer() {}
+
+// UnsafeContractStubServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ContractStubServer will
+// result in compilation errors.
+type UnsafeContractStubServer interface {
+	mustEmbedUnimplementedContractStubServer()
+}
+
+func RegisterContractStubServer(s grpc.ServiceRegistrar, srv ContractStubServer) {
+	s.RegisterService(&ContractStub_ServiceDesc, srv)
+}
+
+func _ContractStub_Invoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractInvokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContractStubServer).Invoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaincode.ContractStub/Invoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContractStubServer).Invoke(ctx, req.(*ContractInvokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContractStub_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContractStubServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaincode.ContractStub/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContractStubServer).Query(ctx, req.(*ContractQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContractStub_Submit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractInvokeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContractStubServer).Submit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaincode.ContractStub/Submit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContractStubServer).Submit(ctx, req.(*ContractInvokeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContractStub_CommitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractCommitStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContractStubServer).CommitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chaincode.ContractStub/CommitStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContractStubServer).CommitStatus(ctx, req.(*ContractCommitStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ContractStub_ServiceDesc is the grpc.ServiceDesc for ContractStub service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ContractStub_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "chaincode.ContractStub",
+	HandlerType: (*ContractStubServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Invoke",
+			Handler:    _ContractStub_Invoke_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _ContractStub_Query_Handler,
+		},
+		{
+			MethodName: "Submit",
+			Handler:    _ContractStub_Submit_Handler,
+		},
+		{
+			MethodName: "CommitStatus",
+			Handler:    _ContractStub_CommitStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "chaincode.proto",
+}
@@ -0,0 +1,369 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package protoutil
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ChannelStubClient is the client API for ChannelStub service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ChannelStubClient interface {
+	// 创建通道
+	CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*Response, error)
+	// 节点加入通道
+	JoinChannel(ctx context.Context, in *JoinChannelRequest, opts ...grpc.CallOption) (*JoinChannelResponse, error)
+	// 跟新通道
+	UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*Response, error)
+	// 账本中获取区块
+	QueryBlock(ctx context.Context, in *QueryBlockRequest, opts ...grpc.CallOption) (*QueryBlockResponse, error)
+	// 拉取区块
+	FetchBlock(ctx context.Context, in *FetchBlockRequest, opts ...grpc.CallOption) (*FetchBlockResponse, error)
+	// 拉取通道初始区块
+	FetchConfig(ctx context.Context, in *FetchConfigRequest, opts ...grpc.CallOption) (*FetchConfigResponse, error)
+	// 获取
+	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	// 获取通道高度及区块哈希
+	GetChannelInfo(ctx context.Context, in *GetChannelInfoRequest, opts ...grpc.CallOption) (*GetChannelInfoResponse, error)
+}
+
+type channelStubClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewChannelStubClient(cc grpc.ClientConnInterface) ChannelStubClient {
+	return &channelStubClient{cc}
+}
+
+func (c *channelStubClient) CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/channel.ChannelStub/CreateChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelStubClient) JoinChannel(ctx context.Context, in *JoinChannelRequest, opts ...grpc.CallOption) (*JoinChannelResponse, error) {
+	out := new(JoinChannelResponse)
+	err := c.cc.Invoke(ctx, "/channel.ChannelStub/JoinChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelStubClient) UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/channel.ChannelStub/UpdateChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelStubClient) QueryBlock(ctx context.Context, in *QueryBlockRequest, opts ...grpc.CallOption) (*QueryBlockResponse, error) {
+	out := new(QueryBlockResponse)
+	err := c.cc.Invoke(ctx, "/channel.ChannelStub/QueryBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelStubClient) FetchBlock(ctx context.Context, in *FetchBlockRequest, opts ...grpc.CallOption) (*FetchBlockResponse, error) {
+	out := new(FetchBlockResponse)
+	err := c.cc.Invoke(ctx, "/channel.ChannelStub/FetchBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelStubClient) FetchConfig(ctx context.Context, in *FetchConfigRequest, opts ...grpc.CallOption) (*FetchConfigResponse, error) {
+	out := new(FetchConfigResponse)
+	err := c.cc.Invoke(ctx, "/channel.ChannelStub/FetchConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelStubClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	out := new(ListChannelsResponse)
+	err := c.cc.Invoke(ctx, "/channel.ChannelStub/ListChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelStubClient) GetChannelInfo(ctx context.Context, in *GetChannelInfoRequest, opts ...grpc.CallOption) (*GetChannelInfoResponse, error) {
+	out := new(GetChannelInfoResponse)
+	err := c.cc.Invoke(ctx, "/channel.ChannelStub/GetChannelInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChannelStubServer is the server API for ChannelStub service.
+// All implementations must embed UnimplementedChannelStubServer
+// for forward compatibility
+type ChannelStubServer interface {
+	// 创建通道
+	CreateChannel(context.Context, *CreateChannelRequest) (*Response, error)
+	// 节点加入通道
+	JoinChannel(context.Context, *JoinChannelRequest) (*JoinChannelResponse, error)
+	// 跟新通道
+	UpdateChannel(context.Context, *UpdateChannelRequest) (*Response, error)
+	// 账本中获取区块
+	QueryBlock(context.Context, *QueryBlockRequest) (*QueryBlockResponse, error)
+	// 拉取区块
+	FetchBlock(context.Context, *FetchBlockRequest) (*FetchBlockResponse, error)
+	// 拉取通道初始区块
+	FetchConfig(context.Context, *FetchConfigRequest) (*FetchConfigResponse, error)
+	// 获取
+	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
+	// 获取通道高度及区块哈希
+	GetChannelInfo(context.Context, *GetChannelInfoRequest) (*GetChannelInfoResponse, error)
+	mustEmbedUnimplementedChannelStubServer()
+}
+
+// UnimplementedChannelStubServer must be embedded to have forward compatible implementations.
+type UnimplementedChannelStubServer struct {
+}
+
+func (UnimplementedChannelStubServer) CreateChannel(context.Context, *CreateChannelRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChannel not implemented")
+}
+func (UnimplementedChannelStubServer) JoinChannel(context.Context, *JoinChannelRequest) (*JoinChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinChannel not implemented")
+}
+func (UnimplementedChannelStubServer) UpdateChannel(context.Context, *UpdateChannelRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChannel not implemented")
+}
+func (UnimplementedChannelStubServer) QueryBlock(context.Context, *QueryBlockRequest) (*QueryBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBlock not implemented")
+}
+func (UnimplementedChannelStubServer) FetchBlock(context.Context, *FetchBlockRequest) (*FetchBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchBlock not implemented")
+}
+func (UnimplementedChannelStubServer) FetchConfig(context.Context, *FetchConfigRequest) (*FetchConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchConfig not implemented")
+}
+func (UnimplementedChannelStubServer) ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannels not implemented")
+}
+func (UnimplementedChannelStubServer) GetChannelInfo(context.Context, *GetChannelInfoRequest) (*GetChannelInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChannelInfo not implemented")
+}
+func (UnimplementedChannelStubServer) mustEmbedUnimplementedChannelStubServer() {}
+
+// UnsafeChannelStubServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChannelStubServer will
+// result in compilation errors.
+type UnsafeChannelStubServer interface {
+	mustEmbedUnimplementedChannelStubServer()
+}
+
+func RegisterChannelStubServer(s grpc.ServiceRegistrar, srv ChannelStubServer) {
+	s.RegisterService(&ChannelStub_ServiceDesc, srv)
+}
+
+func _ChannelStub_CreateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelStubServer).CreateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.ChannelStub/CreateChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelStubServer).CreateChannel(ctx, req.(*CreateChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelStub_JoinChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelStubServer).JoinChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.ChannelStub/JoinChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelStubServer).JoinChannel(ctx, req.(*JoinChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelStub_UpdateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelStubServer).UpdateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.ChannelStub/UpdateChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelStubServer).UpdateChannel(ctx, req.(*UpdateChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelStub_QueryBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelStubServer).QueryBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.ChannelStub/QueryBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelStubServer).QueryBlock(ctx, req.(*QueryBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelStub_FetchBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelStubServer).FetchBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.ChannelStub/FetchBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelStubServer).FetchBlock(ctx, req.(*FetchBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelStub_FetchConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelStubServer).FetchConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.ChannelStub/FetchConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelStubServer).FetchConfig(ctx, req.(*FetchConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelStub_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelStubServer).ListChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.ChannelStub/ListChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelStubServer).ListChannels(ctx, req.(*ListChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelStub_GetChannelInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelStubServer).GetChannelInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/channel.ChannelStub/GetChannelInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelStubServer).GetChannelInfo(ctx, req.(*GetChannelInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChannelStub_ServiceDesc is the grpc.ServiceDesc for ChannelStub service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ChannelStub_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "channel.ChannelStub",
+	HandlerType: (*ChannelStubServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateChannel",
+			Handler:    _ChannelStub_CreateChannel_Handler,
+		},
+		{
+			MethodName: "JoinChannel",
+			Handler:    _ChannelStub_JoinChannel_Handler,
+		},
+		{
+			MethodName: "UpdateChannel",
+			Handler:    _ChannelStub_UpdateChannel_Handler,
+		},
+		{
+			MethodName: "QueryBlock",
+			Handler:    _ChannelStub_QueryBlock_Handler,
+		},
+		{
+			MethodName: "FetchBlock",
+			Handler:    _ChannelStub_FetchBlock_Handler,
+		},
+		{
+			MethodName: "FetchConfig",
+			Handler:    _ChannelStub_FetchConfig_Handler,
+		},
+		{
+			MethodName: "ListChannels",
+			Handler:    _ChannelStub_ListChannels_Handler,
+		},
+		{
+			MethodName: "GetChannelInfo",
+			Handler:    _ChannelStub_GetChannelInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "channel.proto",
+}
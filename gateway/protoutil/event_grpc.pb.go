@@ -0,0 +1,195 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package protoutil
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// EventStubClient is the client API for EventStub service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EventStubClient interface {
+	// 订阅链码事件，直到客户端取消
+	ChaincodeEvents(ctx context.Context, in *ChaincodeEventsRequest, opts ...grpc.CallOption) (EventStub_ChaincodeEventsClient, error)
+	// 监听区块，连接断开时自动重连并从下一个区块继续，直到客户端取消
+	Blocks(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (EventStub_BlocksClient, error)
+}
+
+type eventStubClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventStubClient(cc grpc.ClientConnInterface) EventStubClient {
+	return &eventStubClient{cc}
+}
+
+func (c *eventStubClient) ChaincodeEvents(ctx context.Context, in *ChaincodeEventsRequest, opts ...grpc.CallOption) (EventStub_ChaincodeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EventStub_ServiceDesc.Streams[0], "/event.EventStub/ChaincodeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventStubChaincodeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EventStub_ChaincodeEventsClient interface {
+	Recv() (*ChaincodeEvent, error)
+	grpc.ClientStream
+}
+
+type eventStubChaincodeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventStubChaincodeEventsClient) Recv() (*ChaincodeEvent, error) {
+	m := new(ChaincodeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *eventStubClient) Blocks(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (EventStub_BlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &EventStub_ServiceDesc.Streams[1], "/event.EventStub/Blocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventStubBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EventStub_BlocksClient interface {
+	Recv() (*BlockEvent, error)
+	grpc.ClientStream
+}
+
+type eventStubBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventStubBlocksClient) Recv() (*BlockEvent, error) {
+	m := new(BlockEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EventStubServer is the server API for EventStub service.
+// All implementations must embed UnimplementedEventStubServer
+// for forward compatibility
+type EventStubServer interface {
+	// 订阅链码事件，直到客户端取消
+	ChaincodeEvents(*ChaincodeEventsRequest, EventStub_ChaincodeEventsServer) error
+	// 监听区块，连接断开时自动重连并从下一个区块继续，直到客户端取消
+	Blocks(*BlocksRequest, EventStub_BlocksServer) error
+	mustEmbedUnimplementedEventStubServer()
+}
+
+// UnimplementedEventStubServer must be embedded to have forward compatible implementations.
+type UnimplementedEventStubServer struct {
+}
+
+func (UnimplementedEventStubServer) ChaincodeEvents(*ChaincodeEventsRequest, EventStub_ChaincodeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ChaincodeEvents not implemented")
+}
+func (UnimplementedEventStubServer) Blocks(*BlocksRequest, EventStub_BlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method Blocks not implemented")
+}
+func (UnimplementedEventStubServer) mustEmbedUnimplementedEventStubServer() {}
+
+// UnsafeEventStubServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventStubServer will
+// result in compilation errors.
+type UnsafeEventStubServer interface {
+	mustEmbedUnimplementedEventStubServer()
+}
+
+func RegisterEventStubServer(s grpc.ServiceRegistrar, srv EventStubServer) {
+	s.RegisterService(&EventStub_ServiceDesc, srv)
+}
+
+func _EventStub_ChaincodeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChaincodeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventStubServer).ChaincodeEvents(m, &eventStubChaincodeEventsServer{stream})
+}
+
+type EventStub_ChaincodeEventsServer interface {
+	Send(*ChaincodeEvent) error
+	grpc.ServerStream
+}
+
+type eventStubChaincodeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventStubChaincodeEventsServer) Send(m *ChaincodeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EventStub_Blocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventStubServer).Blocks(m, &eventStubBlocksServer{stream})
+}
+
+type EventStub_BlocksServer interface {
+	Send(*BlockEvent) error
+	grpc.ServerStream
+}
+
+type eventStubBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventStubBlocksServer) Send(m *BlockEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// EventStub_ServiceDesc is the grpc.ServiceDesc for EventStub service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventStub_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "event.EventStub",
+	HandlerType: (*EventStubServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ChaincodeEvents",
+			Handler:       _EventStub_ChaincodeEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Blocks",
+			Handler:       _EventStub_Blocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "event.proto",
+}
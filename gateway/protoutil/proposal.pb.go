@@ -28,34 +28,64 @@ const _ = proto.ProtoPackageIsVersion4
 type ProposalType int32
 
 const (
-	ProposalType_Unknown_Proposal         ProposalType = 0
-	ProposalType_Channel_AddPeerOrg       ProposalType = 1 // 通道加入peer组织
-	ProposalType_Channel_RemovePeerOrg    ProposalType = 2 // 通道删除peer组织
-	ProposalType_Channel_ConfigUpdate     ProposalType = 3 // 通道配置更新
-	ProposalType_Consortium_AddPeerOrg    ProposalType = 4 // peer组织加入联盟
-	ProposalType_Consortium_RemovePeerOrg ProposalType = 5 // 联盟移除peer组织
-	ProposalType_Consortium_ConfigUpdate  ProposalType = 6 // 联盟配置更新
+	ProposalType_Unknown_Proposal             ProposalType = 0
+	ProposalType_Channel_AddPeerOrg           ProposalType = 1  // 通道加入peer组织
+	ProposalType_Channel_RemovePeerOrg        ProposalType = 2  // 通道删除peer组织
+	ProposalType_Channel_ConfigUpdate         ProposalType = 3  // 通道配置更新
+	ProposalType_Consortium_AddPeerOrg        ProposalType = 4  // peer组织加入联盟
+	ProposalType_Consortium_RemovePeerOrg     ProposalType = 5  // 联盟移除peer组织
+	ProposalType_Consortium_ConfigUpdate      ProposalType = 6  // 联盟配置更新
+	ProposalType_Channel_SetAnchorPeers       ProposalType = 7  // 替换组织的锚节点
+	ProposalType_Channel_AddAnchorPeers       ProposalType = 8  // 添加组织的锚节点
+	ProposalType_Channel_RemoveAnchorPeers    ProposalType = 9  // 删除组织的锚节点
+	ProposalType_Orderer_AddConsenter         ProposalType = 10 // etcdraft集群添加共识节点
+	ProposalType_Orderer_RemoveConsenter      ProposalType = 11 // etcdraft集群删除共识节点
+	ProposalType_Orderer_RotateConsenterCerts ProposalType = 12 // 替换共识节点的TLS证书
+	ProposalType_Orderer_UpdateRaftOptions    ProposalType = 13 // 修改etcdraft选项
+	ProposalType_Orderer_SetMaintenanceMode   ProposalType = 14 // 进入或者退出维护状态
+	ProposalType_Orderer_AddOrg               ProposalType = 15 // 排序组织加入Orderer组
+	ProposalType_Orderer_RemoveOrg            ProposalType = 16 // Orderer组删除排序组织
 )
 
 // Enum value maps for ProposalType.
 var (
 	ProposalType_name = map[int32]string{
-		0: "Unknown_Proposal",
-		1: "Channel_AddPeerOrg",
-		2: "Channel_RemovePeerOrg",
-		3: "Channel_ConfigUpdate",
-		4: "Consortium_AddPeerOrg",
-		5: "Consortium_RemovePeerOrg",
-		6: "Consortium_ConfigUpdate",
+		0:  "Unknown_Proposal",
+		1:  "Channel_AddPeerOrg",
+		2:  "Channel_RemovePeerOrg",
+		3:  "Channel_ConfigUpdate",
+		4:  "Consortium_AddPeerOrg",
+		5:  "Consortium_RemovePeerOrg",
+		6:  "Consortium_ConfigUpdate",
+		7:  "Channel_SetAnchorPeers",
+		8:  "Channel_AddAnchorPeers",
+		9:  "Channel_RemoveAnchorPeers",
+		10: "Orderer_AddConsenter",
+		11: "Orderer_RemoveConsenter",
+		12: "Orderer_RotateConsenterCerts",
+		13: "Orderer_UpdateRaftOptions",
+		14: "Orderer_SetMaintenanceMode",
+		15: "Orderer_AddOrg",
+		16: "Orderer_RemoveOrg",
 	}
 	ProposalType_value = map[string]int32{
-		"Unknown_Proposal":         0,
-		"Channel_AddPeerOrg":       1,
-		"Channel_RemovePeerOrg":    2,
-		"Channel_ConfigUpdate":     3,
-		"Consortium_AddPeerOrg":    4,
-		"Consortium_RemovePeerOrg": 5,
-		"Consortium_ConfigUpdate":  6,
+		"Unknown_Proposal":             0,
+		"Channel_AddPeerOrg":           1,
+		"Channel_RemovePeerOrg":        2,
+		"Channel_ConfigUpdate":         3,
+		"Consortium_AddPeerOrg":        4,
+		"Consortium_RemovePeerOrg":     5,
+		"Consortium_ConfigUpdate":      6,
+		"Channel_SetAnchorPeers":       7,
+		"Channel_AddAnchorPeers":       8,
+		"Channel_RemoveAnchorPeers":    9,
+		"Orderer_AddConsenter":         10,
+		"Orderer_RemoveConsenter":      11,
+		"Orderer_RotateConsenterCerts": 12,
+		"Orderer_UpdateRaftOptions":    13,
+		"Orderer_SetMaintenanceMode":   14,
+		"Orderer_AddOrg":               15,
+		"Orderer_RemoveOrg":            16,
 	}
 )
 
@@ -86,6 +116,868 @@ func (ProposalType) EnumDescriptor() ([]byte, []int) {
 	return file_proposal_proto_rawDescGZIP(), []int{0}
 }
 
+// 提案状态
+type ProposalState int32
+
+const (
+	ProposalState_State_Unknown              ProposalState = 0
+	ProposalState_State_Initiated            ProposalState = 1 // 已发起，只有发起者签名
+	ProposalState_State_CollectingSignatures ProposalState = 2 // 收集签名中
+	ProposalState_State_Ready                ProposalState = 3 // 签名足够，可以提交
+	ProposalState_State_Submitted            ProposalState = 4 // 已提交到orderer
+	ProposalState_State_Committed            ProposalState = 5 // 已写入通道配置
+	ProposalState_State_Expired              ProposalState = 6 // 超过截止时间未提交
+	ProposalState_State_Rejected             ProposalState = 7 // 被orderer拒绝或者通道配置已变更
+)
+
+// Enum value maps for ProposalState.
+var (
+	ProposalState_name = map[int32]string{
+		0: "State_Unknown",
+		1: "State_Initiated",
+		2: "State_CollectingSignatures",
+		3: "State_Ready",
+		4: "State_Submitted",
+		5: "State_Committed",
+		6: "State_Expired",
+		7: "State_Rejected",
+	}
+	ProposalState_value = map[string]int32{
+		"State_Unknown":              0,
+		"State_Initiated":            1,
+		"State_CollectingSignatures": 2,
+		"State_Ready":                3,
+		"State_Submitted":            4,
+		"State_Committed":            5,
+		"State_Expired":              6,
+		"State_Rejected":             7,
+	}
+)
+
+func (x ProposalState) Enum() *ProposalState {
+	p := new(ProposalState)
+	*p = x
+	return p
+}
+
+func (x ProposalState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProposalState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proposal_proto_enumTypes[1].Descriptor()
+}
+
+func (ProposalState) Type() protoreflect.EnumType {
+	return &file_proposal_proto_enumTypes[1]
+}
+
+func (x ProposalState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProposalState.Descriptor instead.
+func (ProposalState) EnumDescriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{1}
+}
+
+// 配置所在的组
+type ConfigGroup int32
+
+const (
+	ConfigGroup_Group_Channel     ConfigGroup = 0
+	ConfigGroup_Group_Application ConfigGroup = 1
+	ConfigGroup_Group_Orderer     ConfigGroup = 2
+	ConfigGroup_Group_Consortium  ConfigGroup = 3 // 系统通道中 consortium_name 对应的联盟
+)
+
+// Enum value maps for ConfigGroup.
+var (
+	ConfigGroup_name = map[int32]string{
+		0: "Group_Channel",
+		1: "Group_Application",
+		2: "Group_Orderer",
+		3: "Group_Consortium",
+	}
+	ConfigGroup_value = map[string]int32{
+		"Group_Channel":     0,
+		"Group_Application": 1,
+		"Group_Orderer":     2,
+		"Group_Consortium":  3,
+	}
+)
+
+func (x ConfigGroup) Enum() *ConfigGroup {
+	p := new(ConfigGroup)
+	*p = x
+	return p
+}
+
+func (x ConfigGroup) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigGroup) Descriptor() protoreflect.EnumDescriptor {
+	return file_proposal_proto_enumTypes[2].Descriptor()
+}
+
+func (ConfigGroup) Type() protoreflect.EnumType {
+	return &file_proposal_proto_enumTypes[2]
+}
+
+func (x ConfigGroup) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigGroup.Descriptor instead.
+func (ConfigGroup) EnumDescriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{2}
+}
+
+// 出块大小，为0的项不修改
+type BatchSize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxMessageCount   uint32 `protobuf:"varint,1,opt,name=max_message_count,json=maxMessageCount,proto3" json:"max_message_count,omitempty"`
+	AbsoluteMaxBytes  uint32 `protobuf:"varint,2,opt,name=absolute_max_bytes,json=absoluteMaxBytes,proto3" json:"absolute_max_bytes,omitempty"`
+	PreferredMaxBytes uint32 `protobuf:"varint,3,opt,name=preferred_max_bytes,json=preferredMaxBytes,proto3" json:"preferred_max_bytes,omitempty"`
+}
+
+func (x *BatchSize) Reset() {
+	*x = BatchSize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proposal_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSize) ProtoMessage() {}
+
+func (x *BatchSize) ProtoReflect() protoreflect.Message {
+	mi := &file_proposal_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSize.ProtoReflect.Descriptor instead.
+func (*BatchSize) Descriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatchSize) GetMaxMessageCount() uint32 {
+	if x != nil {
+		return x.MaxMessageCount
+	}
+	return 0
+}
+
+func (x *BatchSize) GetAbsoluteMaxBytes() uint32 {
+	if x != nil {
+		return x.AbsoluteMaxBytes
+	}
+	return 0
+}
+
+func (x *BatchSize) GetPreferredMaxBytes() uint32 {
+	if x != nil {
+		return x.PreferredMaxBytes
+	}
+	return 0
+}
+
+// 策略修改，org_name为空时修改配置组的策略
+type PolicyUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group     ConfigGroup `protobuf:"varint,1,opt,name=group,proto3,enum=proposal.ConfigGroup" json:"group,omitempty"`
+	OrgName   string      `protobuf:"bytes,2,opt,name=org_name,json=orgName,proto3" json:"org_name,omitempty"`
+	Name      string      `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"` // 如 Readers, Writers, Admins, Endorsement
+	Type      string      `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"` // ImplicitMeta 或 Signature
+	Rule      string      `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"` // 如 "MAJORITY Admins" 或 "OR('Org1MSP.admin')"
+	ModPolicy string      `protobuf:"bytes,6,opt,name=mod_policy,json=modPolicy,proto3" json:"mod_policy,omitempty"`
+	Remove    bool        `protobuf:"varint,7,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (x *PolicyUpdate) Reset() {
+	*x = PolicyUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proposal_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PolicyUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyUpdate) ProtoMessage() {}
+
+func (x *PolicyUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proposal_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyUpdate.ProtoReflect.Descriptor instead.
+func (*PolicyUpdate) Descriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PolicyUpdate) GetGroup() ConfigGroup {
+	if x != nil {
+		return x.Group
+	}
+	return ConfigGroup_Group_Channel
+}
+
+func (x *PolicyUpdate) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *PolicyUpdate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PolicyUpdate) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PolicyUpdate) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *PolicyUpdate) GetModPolicy() string {
+	if x != nil {
+		return x.ModPolicy
+	}
+	return ""
+}
+
+func (x *PolicyUpdate) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+// 能力修改，如 V2_0
+type CapabilityUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group      ConfigGroup `protobuf:"varint,1,opt,name=group,proto3,enum=proposal.ConfigGroup" json:"group,omitempty"`
+	Capability string      `protobuf:"bytes,2,opt,name=capability,proto3" json:"capability,omitempty"`
+	Remove     bool        `protobuf:"varint,3,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (x *CapabilityUpdate) Reset() {
+	*x = CapabilityUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proposal_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapabilityUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapabilityUpdate) ProtoMessage() {}
+
+func (x *CapabilityUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proposal_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapabilityUpdate.ProtoReflect.Descriptor instead.
+func (*CapabilityUpdate) Descriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CapabilityUpdate) GetGroup() ConfigGroup {
+	if x != nil {
+		return x.Group
+	}
+	return ConfigGroup_Group_Channel
+}
+
+func (x *CapabilityUpdate) GetCapability() string {
+	if x != nil {
+		return x.Capability
+	}
+	return ""
+}
+
+func (x *CapabilityUpdate) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+// 组织MSP修改，证书和CRL均为PEM格式
+type MSPUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group                       ConfigGroup `protobuf:"varint,1,opt,name=group,proto3,enum=proposal.ConfigGroup" json:"group,omitempty"`
+	OrgName                     string      `protobuf:"bytes,2,opt,name=org_name,json=orgName,proto3" json:"org_name,omitempty"`
+	AddedRootCerts              [][]byte    `protobuf:"bytes,3,rep,name=added_root_certs,json=addedRootCerts,proto3" json:"added_root_certs,omitempty"`
+	RemovedRootCerts            [][]byte    `protobuf:"bytes,4,rep,name=removed_root_certs,json=removedRootCerts,proto3" json:"removed_root_certs,omitempty"`
+	AddedIntermediateCerts      [][]byte    `protobuf:"bytes,5,rep,name=added_intermediate_certs,json=addedIntermediateCerts,proto3" json:"added_intermediate_certs,omitempty"`
+	RemovedIntermediateCerts    [][]byte    `protobuf:"bytes,6,rep,name=removed_intermediate_certs,json=removedIntermediateCerts,proto3" json:"removed_intermediate_certs,omitempty"`
+	AddedAdminCerts             [][]byte    `protobuf:"bytes,7,rep,name=added_admin_certs,json=addedAdminCerts,proto3" json:"added_admin_certs,omitempty"`
+	RemovedAdminCerts           [][]byte    `protobuf:"bytes,8,rep,name=removed_admin_certs,json=removedAdminCerts,proto3" json:"removed_admin_certs,omitempty"`
+	AddedTlsRootCerts           [][]byte    `protobuf:"bytes,9,rep,name=added_tls_root_certs,json=addedTlsRootCerts,proto3" json:"added_tls_root_certs,omitempty"`
+	RemovedTlsRootCerts         [][]byte    `protobuf:"bytes,10,rep,name=removed_tls_root_certs,json=removedTlsRootCerts,proto3" json:"removed_tls_root_certs,omitempty"`
+	AddedTlsIntermediateCerts   [][]byte    `protobuf:"bytes,11,rep,name=added_tls_intermediate_certs,json=addedTlsIntermediateCerts,proto3" json:"added_tls_intermediate_certs,omitempty"`
+	RemovedTlsIntermediateCerts [][]byte    `protobuf:"bytes,12,rep,name=removed_tls_intermediate_certs,json=removedTlsIntermediateCerts,proto3" json:"removed_tls_intermediate_certs,omitempty"`
+	Crls                        [][]byte    `protobuf:"bytes,13,rep,name=crls,proto3" json:"crls,omitempty"`
+}
+
+func (x *MSPUpdate) Reset() {
+	*x = MSPUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proposal_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MSPUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MSPUpdate) ProtoMessage() {}
+
+func (x *MSPUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proposal_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MSPUpdate.ProtoReflect.Descriptor instead.
+func (*MSPUpdate) Descriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MSPUpdate) GetGroup() ConfigGroup {
+	if x != nil {
+		return x.Group
+	}
+	return ConfigGroup_Group_Channel
+}
+
+func (x *MSPUpdate) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *MSPUpdate) GetAddedRootCerts() [][]byte {
+	if x != nil {
+		return x.AddedRootCerts
+	}
+	return nil
+}
+
+func (x *MSPUpdate) GetRemovedRootCerts() [][]byte {
+	if x != nil {
+		return x.RemovedRootCerts
+	}
+	return nil
+}
+
+func (x *MSPUpdate) GetAddedIntermediateCerts() [][]byte {
+	if x != nil {
+		return x.AddedIntermediateCerts
+	}
+	return nil
+}
+
+func (x *MSPUpdate) GetRemovedIntermediateCerts() [][]byte {
+	if x != nil {
+		return x.RemovedIntermediateCerts
+	}
+	return nil
+}
+
+func (x *MSPUpdate) GetAddedAdminCerts() [][]byte {
+	if x != nil {
+		return x.AddedAdminCerts
+	}
+	return nil
+}
+
+func (x *MSPUpdate) GetRemovedAdminCerts() [][]byte {
+	if x != nil {
+		return x.RemovedAdminCerts
+	}
+	return nil
+}
+
+func (x *MSPUpdate) GetAddedTlsRootCerts() [][]byte {
+	if x != nil {
+		return x.AddedTlsRootCerts
+	}
+	return nil
+}
+
+func (x *MSPUpdate) GetRemovedTlsRootCerts() [][]byte {
+	if x != nil {
+		return x.RemovedTlsRootCerts
+	}
+	return nil
+}
+
+func (x *MSPUpdate) GetAddedTlsIntermediateCerts() [][]byte {
+	if x != nil {
+		return x.AddedTlsIntermediateCerts
+	}
+	return nil
+}
+
+func (x *MSPUpdate) GetRemovedTlsIntermediateCerts() [][]byte {
+	if x != nil {
+		return x.RemovedTlsIntermediateCerts
+	}
+	return nil
+}
+
+func (x *MSPUpdate) GetCrls() [][]byte {
+	if x != nil {
+		return x.Crls
+	}
+	return nil
+}
+
+// 通道或联盟配置修改，只修改设置的项
+type ConfigModification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchSize    *BatchSize          `protobuf:"bytes,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	BatchTimeout string              `protobuf:"bytes,2,opt,name=batch_timeout,json=batchTimeout,proto3" json:"batch_timeout,omitempty"` // 如 "2s"
+	Policies     []*PolicyUpdate     `protobuf:"bytes,3,rep,name=policies,proto3" json:"policies,omitempty"`
+	Capabilities []*CapabilityUpdate `protobuf:"bytes,4,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Acls         map[string]string   `protobuf:"bytes,5,rep,name=acls,proto3" json:"acls,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // 资源名称 -> 策略路径，只用于应用通道
+	RemovedAcls  []string            `protobuf:"bytes,6,rep,name=removed_acls,json=removedAcls,proto3" json:"removed_acls,omitempty"`
+	Msps         []*MSPUpdate        `protobuf:"bytes,7,rep,name=msps,proto3" json:"msps,omitempty"`
+}
+
+func (x *ConfigModification) Reset() {
+	*x = ConfigModification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proposal_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigModification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigModification) ProtoMessage() {}
+
+func (x *ConfigModification) ProtoReflect() protoreflect.Message {
+	mi := &file_proposal_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigModification.ProtoReflect.Descriptor instead.
+func (*ConfigModification) Descriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConfigModification) GetBatchSize() *BatchSize {
+	if x != nil {
+		return x.BatchSize
+	}
+	return nil
+}
+
+func (x *ConfigModification) GetBatchTimeout() string {
+	if x != nil {
+		return x.BatchTimeout
+	}
+	return ""
+}
+
+func (x *ConfigModification) GetPolicies() []*PolicyUpdate {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *ConfigModification) GetCapabilities() []*CapabilityUpdate {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *ConfigModification) GetAcls() map[string]string {
+	if x != nil {
+		return x.Acls
+	}
+	return nil
+}
+
+func (x *ConfigModification) GetRemovedAcls() []string {
+	if x != nil {
+		return x.RemovedAcls
+	}
+	return nil
+}
+
+func (x *ConfigModification) GetMsps() []*MSPUpdate {
+	if x != nil {
+		return x.Msps
+	}
+	return nil
+}
+
+// 组织锚节点修改
+type AnchorPeersUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrgName     string        `protobuf:"bytes,1,opt,name=org_name,json=orgName,proto3" json:"org_name,omitempty"`
+	AnchorPeers []*AnchorPeer `protobuf:"bytes,2,rep,name=anchor_peers,json=anchorPeers,proto3" json:"anchor_peers,omitempty"`
+}
+
+func (x *AnchorPeersUpdate) Reset() {
+	*x = AnchorPeersUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proposal_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnchorPeersUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnchorPeersUpdate) ProtoMessage() {}
+
+func (x *AnchorPeersUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proposal_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnchorPeersUpdate.ProtoReflect.Descriptor instead.
+func (*AnchorPeersUpdate) Descriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AnchorPeersUpdate) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *AnchorPeersUpdate) GetAnchorPeers() []*AnchorPeer {
+	if x != nil {
+		return x.AnchorPeers
+	}
+	return nil
+}
+
+// etcdraft共识节点，证书为PEM格式
+type Consenter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host          string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port          int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	ServerTlsCert []byte `protobuf:"bytes,3,opt,name=server_tls_cert,json=serverTlsCert,proto3" json:"server_tls_cert,omitempty"`
+	ClientTlsCert []byte `protobuf:"bytes,4,opt,name=client_tls_cert,json=clientTlsCert,proto3" json:"client_tls_cert,omitempty"`
+}
+
+func (x *Consenter) Reset() {
+	*x = Consenter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proposal_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Consenter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Consenter) ProtoMessage() {}
+
+func (x *Consenter) ProtoReflect() protoreflect.Message {
+	mi := &file_proposal_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Consenter.ProtoReflect.Descriptor instead.
+func (*Consenter) Descriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Consenter) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Consenter) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Consenter) GetServerTlsCert() []byte {
+	if x != nil {
+		return x.ServerTlsCert
+	}
+	return nil
+}
+
+func (x *Consenter) GetClientTlsCert() []byte {
+	if x != nil {
+		return x.ClientTlsCert
+	}
+	return nil
+}
+
+// etcdraft选项，为0或者为空的项不修改
+type RaftOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TickInterval         string `protobuf:"bytes,1,opt,name=tick_interval,json=tickInterval,proto3" json:"tick_interval,omitempty"` // 如 "500ms"
+	ElectionTick         uint32 `protobuf:"varint,2,opt,name=election_tick,json=electionTick,proto3" json:"election_tick,omitempty"`
+	HeartbeatTick        uint32 `protobuf:"varint,3,opt,name=heartbeat_tick,json=heartbeatTick,proto3" json:"heartbeat_tick,omitempty"`
+	MaxInflightBlocks    uint32 `protobuf:"varint,4,opt,name=max_inflight_blocks,json=maxInflightBlocks,proto3" json:"max_inflight_blocks,omitempty"`
+	SnapshotIntervalSize uint32 `protobuf:"varint,5,opt,name=snapshot_interval_size,json=snapshotIntervalSize,proto3" json:"snapshot_interval_size,omitempty"` // 字节
+}
+
+func (x *RaftOptions) Reset() {
+	*x = RaftOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proposal_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RaftOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RaftOptions) ProtoMessage() {}
+
+func (x *RaftOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proposal_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RaftOptions.ProtoReflect.Descriptor instead.
+func (*RaftOptions) Descriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RaftOptions) GetTickInterval() string {
+	if x != nil {
+		return x.TickInterval
+	}
+	return ""
+}
+
+func (x *RaftOptions) GetElectionTick() uint32 {
+	if x != nil {
+		return x.ElectionTick
+	}
+	return 0
+}
+
+func (x *RaftOptions) GetHeartbeatTick() uint32 {
+	if x != nil {
+		return x.HeartbeatTick
+	}
+	return 0
+}
+
+func (x *RaftOptions) GetMaxInflightBlocks() uint32 {
+	if x != nil {
+		return x.MaxInflightBlocks
+	}
+	return 0
+}
+
+func (x *RaftOptions) GetSnapshotIntervalSize() uint32 {
+	if x != nil {
+		return x.SnapshotIntervalSize
+	}
+	return 0
+}
+
+// 排序组织加入或者移出Orderer组
+type OrdererOrgUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Org              *Organization `protobuf:"bytes,1,opt,name=org,proto3" json:"org,omitempty"`                                                    // Orderer_AddOrg，组织类型须为ORDERER
+	OrgName          string        `protobuf:"bytes,2,opt,name=org_name,json=orgName,proto3" json:"org_name,omitempty"`                             // Orderer_RemoveOrg
+	Consenters       []*Consenter  `protobuf:"bytes,3,rep,name=consenters,proto3" json:"consenters,omitempty"`                                      // Orderer_AddOrg 组织的共识节点，地址同时作为组织的排序节点地址
+	UpdateConsenters bool          `protobuf:"varint,4,opt,name=update_consenters,json=updateConsenters,proto3" json:"update_consenters,omitempty"` // 同时把组织的共识节点加入或者移出etcdraft集群，一次最多一个
+}
+
+func (x *OrdererOrgUpdate) Reset() {
+	*x = OrdererOrgUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proposal_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrdererOrgUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrdererOrgUpdate) ProtoMessage() {}
+
+func (x *OrdererOrgUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proposal_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrdererOrgUpdate.ProtoReflect.Descriptor instead.
+func (*OrdererOrgUpdate) Descriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OrdererOrgUpdate) GetOrg() *Organization {
+	if x != nil {
+		return x.Org
+	}
+	return nil
+}
+
+func (x *OrdererOrgUpdate) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *OrdererOrgUpdate) GetConsenters() []*Consenter {
+	if x != nil {
+		return x.Consenters
+	}
+	return nil
+}
+
+func (x *OrdererOrgUpdate) GetUpdateConsenters() bool {
+	if x != nil {
+		return x.UpdateConsenters
+	}
+	return false
+}
+
 type Proposal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -95,14 +987,20 @@ type Proposal struct {
 	// Types that are assignable to Content:
 	//	*Proposal_NewOrg
 	//	*Proposal_RemovedOrgName
+	//	*Proposal_ConfigUpdate
+	//	*Proposal_AnchorPeers
+	//	*Proposal_Consenter
+	//	*Proposal_RaftOptions
+	//	*Proposal_Maintenance
+	//	*Proposal_OrdererOrg
 	Content  isProposal_Content `protobuf_oneof:"content"`
-	Deadline int64              `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Deadline int64              `protobuf:"varint,4,opt,name=deadline,proto3" json:"deadline,omitempty"` // 截止时间（unix秒），0表示不限制
 }
 
 func (x *Proposal) Reset() {
 	*x = Proposal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proposal_proto_msgTypes[0]
+		mi := &file_proposal_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -115,7 +1013,7 @@ func (x *Proposal) String() string {
 func (*Proposal) ProtoMessage() {}
 
 func (x *Proposal) ProtoReflect() protoreflect.Message {
-	mi := &file_proposal_proto_msgTypes[0]
+	mi := &file_proposal_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -128,7 +1026,7 @@ func (x *Proposal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return file_proposal_proto_rawDescGZIP(), []int{0}
+	return file_proposal_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Proposal) GetType() ProposalType {
@@ -145,18 +1043,60 @@ func (m *Proposal) GetContent() isProposal_Content {
 	return nil
 }
 
-func (x *Proposal) GetNewOrg() *Organization {
-	if x, ok := x.GetContent().(*Proposal_NewOrg); ok {
-		return x.NewOrg
+func (x *Proposal) GetNewOrg() *Organization {
+	if x, ok := x.GetContent().(*Proposal_NewOrg); ok {
+		return x.NewOrg
+	}
+	return nil
+}
+
+func (x *Proposal) GetRemovedOrgName() string {
+	if x, ok := x.GetContent().(*Proposal_RemovedOrgName); ok {
+		return x.RemovedOrgName
+	}
+	return ""
+}
+
+func (x *Proposal) GetConfigUpdate() *ConfigModification {
+	if x, ok := x.GetContent().(*Proposal_ConfigUpdate); ok {
+		return x.ConfigUpdate
+	}
+	return nil
+}
+
+func (x *Proposal) GetAnchorPeers() *AnchorPeersUpdate {
+	if x, ok := x.GetContent().(*Proposal_AnchorPeers); ok {
+		return x.AnchorPeers
+	}
+	return nil
+}
+
+func (x *Proposal) GetConsenter() *Consenter {
+	if x, ok := x.GetContent().(*Proposal_Consenter); ok {
+		return x.Consenter
+	}
+	return nil
+}
+
+func (x *Proposal) GetRaftOptions() *RaftOptions {
+	if x, ok := x.GetContent().(*Proposal_RaftOptions); ok {
+		return x.RaftOptions
 	}
 	return nil
 }
 
-func (x *Proposal) GetRemovedOrgName() string {
-	if x, ok := x.GetContent().(*Proposal_RemovedOrgName); ok {
-		return x.RemovedOrgName
+func (x *Proposal) GetMaintenance() bool {
+	if x, ok := x.GetContent().(*Proposal_Maintenance); ok {
+		return x.Maintenance
 	}
-	return ""
+	return false
+}
+
+func (x *Proposal) GetOrdererOrg() *OrdererOrgUpdate {
+	if x, ok := x.GetContent().(*Proposal_OrdererOrg); ok {
+		return x.OrdererOrg
+	}
+	return nil
 }
 
 func (x *Proposal) GetDeadline() int64 {
@@ -178,10 +1118,46 @@ type Proposal_RemovedOrgName struct {
 	RemovedOrgName string `protobuf:"bytes,3,opt,name=removed_org_name,json=removedOrgName,proto3,oneof"`
 }
 
+type Proposal_ConfigUpdate struct {
+	ConfigUpdate *ConfigModification `protobuf:"bytes,5,opt,name=config_update,json=configUpdate,proto3,oneof"` // Channel_ConfigUpdate 和 Consortium_ConfigUpdate
+}
+
+type Proposal_AnchorPeers struct {
+	AnchorPeers *AnchorPeersUpdate `protobuf:"bytes,6,opt,name=anchor_peers,json=anchorPeers,proto3,oneof"` // Channel_SetAnchorPeers, Channel_AddAnchorPeers 和 Channel_RemoveAnchorPeers
+}
+
+type Proposal_Consenter struct {
+	Consenter *Consenter `protobuf:"bytes,7,opt,name=consenter,proto3,oneof"` // Orderer_AddConsenter, Orderer_RemoveConsenter（只需地址） 和 Orderer_RotateConsenterCerts
+}
+
+type Proposal_RaftOptions struct {
+	RaftOptions *RaftOptions `protobuf:"bytes,8,opt,name=raft_options,json=raftOptions,proto3,oneof"` // Orderer_UpdateRaftOptions
+}
+
+type Proposal_Maintenance struct {
+	Maintenance bool `protobuf:"varint,9,opt,name=maintenance,proto3,oneof"` // Orderer_SetMaintenanceMode，false为退出维护状态
+}
+
+type Proposal_OrdererOrg struct {
+	OrdererOrg *OrdererOrgUpdate `protobuf:"bytes,10,opt,name=orderer_org,json=ordererOrg,proto3,oneof"` // Orderer_AddOrg 和 Orderer_RemoveOrg
+}
+
 func (*Proposal_NewOrg) isProposal_Content() {}
 
 func (*Proposal_RemovedOrgName) isProposal_Content() {}
 
+func (*Proposal_ConfigUpdate) isProposal_Content() {}
+
+func (*Proposal_AnchorPeers) isProposal_Content() {}
+
+func (*Proposal_Consenter) isProposal_Content() {}
+
+func (*Proposal_RaftOptions) isProposal_Content() {}
+
+func (*Proposal_Maintenance) isProposal_Content() {}
+
+func (*Proposal_OrdererOrg) isProposal_Content() {}
+
 type ProposalSignature struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -195,7 +1171,7 @@ type ProposalSignature struct {
 func (x *ProposalSignature) Reset() {
 	*x = ProposalSignature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proposal_proto_msgTypes[1]
+		mi := &file_proposal_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -208,7 +1184,7 @@ func (x *ProposalSignature) String() string {
 func (*ProposalSignature) ProtoMessage() {}
 
 func (x *ProposalSignature) ProtoReflect() protoreflect.Message {
-	mi := &file_proposal_proto_msgTypes[1]
+	mi := &file_proposal_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -221,7 +1197,7 @@ func (x *ProposalSignature) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProposalSignature.ProtoReflect.Descriptor instead.
 func (*ProposalSignature) Descriptor() ([]byte, []int) {
-	return file_proposal_proto_rawDescGZIP(), []int{1}
+	return file_proposal_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ProposalSignature) GetProposalHash() []byte {
@@ -254,12 +1230,17 @@ type ProposalEnvelope struct {
 	Proposal   []byte             `protobuf:"bytes,2,opt,name=proposal,proto3" json:"proposal,omitempty"`
 	Sign       *ProposalSignature `protobuf:"bytes,3,opt,name=sign,proto3" json:"sign,omitempty"`
 	ChannelId  string             `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	State      ProposalState      `protobuf:"varint,5,opt,name=state,proto3,enum=proposal.ProposalState" json:"state,omitempty"`
+	Deadline   int64              `protobuf:"varint,6,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Signers    []string           `protobuf:"bytes,7,rep,name=signers,proto3" json:"signers,omitempty"` // 已签名的组织MSP ID
+	Members    []string           `protobuf:"bytes,8,rep,name=members,proto3" json:"members,omitempty"` // 需要签名的组织MSP ID
+	Message    string             `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
 }
 
 func (x *ProposalEnvelope) Reset() {
 	*x = ProposalEnvelope{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proposal_proto_msgTypes[2]
+		mi := &file_proposal_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -272,7 +1253,7 @@ func (x *ProposalEnvelope) String() string {
 func (*ProposalEnvelope) ProtoMessage() {}
 
 func (x *ProposalEnvelope) ProtoReflect() protoreflect.Message {
-	mi := &file_proposal_proto_msgTypes[2]
+	mi := &file_proposal_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,67 +1266,306 @@ func (x *ProposalEnvelope) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProposalEnvelope.ProtoReflect.Descriptor instead.
 func (*ProposalEnvelope) Descriptor() ([]byte, []int) {
-	return file_proposal_proto_rawDescGZIP(), []int{2}
+	return file_proposal_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProposalEnvelope) GetProposalId() []byte {
+	if x != nil {
+		return x.ProposalId
+	}
+	return nil
+}
+
+func (x *ProposalEnvelope) GetProposal() []byte {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+func (x *ProposalEnvelope) GetSign() *ProposalSignature {
+	if x != nil {
+		return x.Sign
+	}
+	return nil
+}
+
+func (x *ProposalEnvelope) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ProposalEnvelope) GetState() ProposalState {
+	if x != nil {
+		return x.State
+	}
+	return ProposalState_State_Unknown
+}
+
+func (x *ProposalEnvelope) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *ProposalEnvelope) GetSigners() []string {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *ProposalEnvelope) GetMembers() []string {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ProposalEnvelope) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 发起提案请求
+type ProposalInitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer         *Signer   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Orderer        *Orderer  `protobuf:"bytes,2,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	ChannelId      string    `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	ConsortiumName string    `protobuf:"bytes,4,opt,name=consortium_name,json=consortiumName,proto3" json:"consortium_name,omitempty"`
+	Proposal       *Proposal `protobuf:"bytes,5,opt,name=proposal,proto3" json:"proposal,omitempty"`
+}
+
+func (x *ProposalInitRequest) Reset() {
+	*x = ProposalInitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proposal_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposalInitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalInitRequest) ProtoMessage() {}
+
+func (x *ProposalInitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proposal_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalInitRequest.ProtoReflect.Descriptor instead.
+func (*ProposalInitRequest) Descriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ProposalInitRequest) GetSigner() *Signer {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *ProposalInitRequest) GetOrderer() *Orderer {
+	if x != nil {
+		return x.Orderer
+	}
+	return nil
+}
+
+func (x *ProposalInitRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ProposalInitRequest) GetConsortiumName() string {
+	if x != nil {
+		return x.ConsortiumName
+	}
+	return ""
+}
+
+func (x *ProposalInitRequest) GetProposal() *Proposal {
+	if x != nil {
+		return x.Proposal
+	}
+	return nil
+}
+
+type ProposalSignRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer   *Signer           `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Envelope *ProposalEnvelope `protobuf:"bytes,2,opt,name=envelope,proto3" json:"envelope,omitempty"`
+}
+
+func (x *ProposalSignRequest) Reset() {
+	*x = ProposalSignRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proposal_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposalSignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalSignRequest) ProtoMessage() {}
+
+func (x *ProposalSignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proposal_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalSignRequest.ProtoReflect.Descriptor instead.
+func (*ProposalSignRequest) Descriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ProposalSignRequest) GetSigner() *Signer {
+	if x != nil {
+		return x.Signer
+	}
+	return nil
+}
+
+func (x *ProposalSignRequest) GetEnvelope() *ProposalEnvelope {
+	if x != nil {
+		return x.Envelope
+	}
+	return nil
+}
+
+type ProposalSubmitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signer   *Signer              `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Orderer  *Orderer             `protobuf:"bytes,2,opt,name=orderer,proto3" json:"orderer,omitempty"`
+	Envelope *ProposalEnvelope    `protobuf:"bytes,3,opt,name=envelope,proto3" json:"envelope,omitempty"`
+	Sigs     []*ProposalSignature `protobuf:"bytes,4,rep,name=sigs,proto3" json:"sigs,omitempty"`
+}
+
+func (x *ProposalSubmitRequest) Reset() {
+	*x = ProposalSubmitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proposal_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProposalSubmitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProposalSubmitRequest) ProtoMessage() {}
+
+func (x *ProposalSubmitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proposal_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProposalSubmitRequest.ProtoReflect.Descriptor instead.
+func (*ProposalSubmitRequest) Descriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ProposalEnvelope) GetProposalId() []byte {
+func (x *ProposalSubmitRequest) GetSigner() *Signer {
 	if x != nil {
-		return x.ProposalId
+		return x.Signer
 	}
 	return nil
 }
 
-func (x *ProposalEnvelope) GetProposal() []byte {
+func (x *ProposalSubmitRequest) GetOrderer() *Orderer {
 	if x != nil {
-		return x.Proposal
+		return x.Orderer
 	}
 	return nil
 }
 
-func (x *ProposalEnvelope) GetSign() *ProposalSignature {
+func (x *ProposalSubmitRequest) GetEnvelope() *ProposalEnvelope {
 	if x != nil {
-		return x.Sign
+		return x.Envelope
 	}
 	return nil
 }
 
-func (x *ProposalEnvelope) GetChannelId() string {
+func (x *ProposalSubmitRequest) GetSigs() []*ProposalSignature {
 	if x != nil {
-		return x.ChannelId
+		return x.Sigs
 	}
-	return ""
+	return nil
 }
 
-// 发起提案请求
-type ProposalInitRequest struct {
+type ProposalQueryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signer         *Signer   `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Orderer        *Orderer  `protobuf:"bytes,2,opt,name=orderer,proto3" json:"orderer,omitempty"`
-	ChannelId      string    `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	ConsortiumName string    `protobuf:"bytes,4,opt,name=consortium_name,json=consortiumName,proto3" json:"consortium_name,omitempty"`
-	Proposal       *Proposal `protobuf:"bytes,5,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	ProposalId []byte `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// signer和orderer可选，设置时已提交的提案按通道配置更新为已生效
+	Signer  *Signer  `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Orderer *Orderer `protobuf:"bytes,3,opt,name=orderer,proto3" json:"orderer,omitempty"`
 }
 
-func (x *ProposalInitRequest) Reset() {
-	*x = ProposalInitRequest{}
+func (x *ProposalQueryRequest) Reset() {
+	*x = ProposalQueryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proposal_proto_msgTypes[3]
+		mi := &file_proposal_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProposalInitRequest) String() string {
+func (x *ProposalQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProposalInitRequest) ProtoMessage() {}
+func (*ProposalQueryRequest) ProtoMessage() {}
 
-func (x *ProposalInitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proposal_proto_msgTypes[3]
+func (x *ProposalQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proposal_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,72 +1576,61 @@ func (x *ProposalInitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProposalInitRequest.ProtoReflect.Descriptor instead.
-func (*ProposalInitRequest) Descriptor() ([]byte, []int) {
-	return file_proposal_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ProposalQueryRequest.ProtoReflect.Descriptor instead.
+func (*ProposalQueryRequest) Descriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ProposalInitRequest) GetSigner() *Signer {
+func (x *ProposalQueryRequest) GetProposalId() []byte {
 	if x != nil {
-		return x.Signer
+		return x.ProposalId
 	}
 	return nil
 }
 
-func (x *ProposalInitRequest) GetOrderer() *Orderer {
+func (x *ProposalQueryRequest) GetSigner() *Signer {
 	if x != nil {
-		return x.Orderer
+		return x.Signer
 	}
 	return nil
 }
 
-func (x *ProposalInitRequest) GetChannelId() string {
-	if x != nil {
-		return x.ChannelId
-	}
-	return ""
-}
-
-func (x *ProposalInitRequest) GetConsortiumName() string {
-	if x != nil {
-		return x.ConsortiumName
-	}
-	return ""
-}
-
-func (x *ProposalInitRequest) GetProposal() *Proposal {
+func (x *ProposalQueryRequest) GetOrderer() *Orderer {
 	if x != nil {
-		return x.Proposal
+		return x.Orderer
 	}
 	return nil
 }
 
-type ProposalSignRequest struct {
+type ProposalListRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signer   *Signer           `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Envelope *ProposalEnvelope `protobuf:"bytes,2,opt,name=envelope,proto3" json:"envelope,omitempty"`
+	ChannelId string        `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`     // 为空时返回所有通道的提案
+	State     ProposalState `protobuf:"varint,2,opt,name=state,proto3,enum=proposal.ProposalState" json:"state,omitempty"` // State_Unknown时返回所有状态的提案
+	// signer和orderer可选，设置时已提交的提案按通道配置更新为已生效
+	Signer  *Signer  `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	Orderer *Orderer `protobuf:"bytes,4,opt,name=orderer,proto3" json:"orderer,omitempty"`
 }
 
-func (x *ProposalSignRequest) Reset() {
-	*x = ProposalSignRequest{}
+func (x *ProposalListRequest) Reset() {
+	*x = ProposalListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proposal_proto_msgTypes[4]
+		mi := &file_proposal_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProposalSignRequest) String() string {
+func (x *ProposalListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProposalSignRequest) ProtoMessage() {}
+func (*ProposalListRequest) ProtoMessage() {}
 
-func (x *ProposalSignRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proposal_proto_msgTypes[4]
+func (x *ProposalListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proposal_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,53 +1641,64 @@ func (x *ProposalSignRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProposalSignRequest.ProtoReflect.Descriptor instead.
-func (*ProposalSignRequest) Descriptor() ([]byte, []int) {
-	return file_proposal_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ProposalListRequest.ProtoReflect.Descriptor instead.
+func (*ProposalListRequest) Descriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ProposalSignRequest) GetSigner() *Signer {
+func (x *ProposalListRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ProposalListRequest) GetState() ProposalState {
+	if x != nil {
+		return x.State
+	}
+	return ProposalState_State_Unknown
+}
+
+func (x *ProposalListRequest) GetSigner() *Signer {
 	if x != nil {
 		return x.Signer
 	}
 	return nil
 }
 
-func (x *ProposalSignRequest) GetEnvelope() *ProposalEnvelope {
+func (x *ProposalListRequest) GetOrderer() *Orderer {
 	if x != nil {
-		return x.Envelope
+		return x.Orderer
 	}
 	return nil
 }
 
-type ProposalSubmitRequest struct {
+type ProposalListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Signer   *Signer              `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
-	Orderer  *Orderer             `protobuf:"bytes,2,opt,name=orderer,proto3" json:"orderer,omitempty"`
-	Envelope *ProposalEnvelope    `protobuf:"bytes,3,opt,name=envelope,proto3" json:"envelope,omitempty"`
-	Sigs     []*ProposalSignature `protobuf:"bytes,4,rep,name=sigs,proto3" json:"sigs,omitempty"`
+	Proposals []*ProposalEnvelope `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
 }
 
-func (x *ProposalSubmitRequest) Reset() {
-	*x = ProposalSubmitRequest{}
+func (x *ProposalListResponse) Reset() {
+	*x = ProposalListResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proposal_proto_msgTypes[5]
+		mi := &file_proposal_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProposalSubmitRequest) String() string {
+func (x *ProposalListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProposalSubmitRequest) ProtoMessage() {}
+func (*ProposalListResponse) ProtoMessage() {}
 
-func (x *ProposalSubmitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proposal_proto_msgTypes[5]
+func (x *ProposalListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proposal_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,35 +1709,14 @@ func (x *ProposalSubmitRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProposalSubmitRequest.ProtoReflect.Descriptor instead.
-func (*ProposalSubmitRequest) Descriptor() ([]byte, []int) {
-	return file_proposal_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ProposalSubmitRequest) GetSigner() *Signer {
-	if x != nil {
-		return x.Signer
-	}
-	return nil
-}
-
-func (x *ProposalSubmitRequest) GetOrderer() *Orderer {
-	if x != nil {
-		return x.Orderer
-	}
-	return nil
-}
-
-func (x *ProposalSubmitRequest) GetEnvelope() *ProposalEnvelope {
-	if x != nil {
-		return x.Envelope
-	}
-	return nil
+// Deprecated: Use ProposalListResponse.ProtoReflect.Descriptor instead.
+func (*ProposalListResponse) Descriptor() ([]byte, []int) {
+	return file_proposal_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ProposalSubmitRequest) GetSigs() []*ProposalSignature {
+func (x *ProposalListResponse) GetProposals() []*ProposalEnvelope {
 	if x != nil {
-		return x.Sigs
+		return x.Proposals
 	}
 	return nil
 }
@@ -527,99 +1726,338 @@ var File_proposal_proto protoreflect.FileDescriptor
 var file_proposal_proto_rawDesc = []byte{
 	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba, 0x01, 0x0a, 0x08, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x2f, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x72, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x95, 0x01, 0x0a, 0x09, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x4d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x12, 0x2e, 0x0a, 0x13, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x4d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0xc9, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x2b, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x77, 0x0a, 0x10,
+	0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x2b, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0xff, 0x04, 0x0a, 0x09, 0x4d, 0x53, 0x50, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x61,
+	0x64, 0x64, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x61, 0x64, 0x64, 0x65, 0x64, 0x52, 0x6f, 0x6f, 0x74,
+	0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
+	0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x65,
+	0x72, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x16, 0x61, 0x64, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x3c, 0x0a,
+	0x1a, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x18, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x61,
+	0x64, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0f, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x11, 0x61, 0x64, 0x64, 0x65, 0x64, 0x54, 0x6c, 0x73, 0x52,
+	0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x65, 0x72,
+	0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x54, 0x6c, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x3f, 0x0a,
+	0x1c, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x19, 0x61, 0x64, 0x64, 0x65, 0x64, 0x54, 0x6c, 0x73, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x73, 0x12, 0x43,
+	0x0a, 0x1e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x1b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x54,
+	0x6c, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x43, 0x65,
+	0x72, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x72, 0x6c, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x04, 0x63, 0x72, 0x6c, 0x73, 0x22, 0xa2, 0x03, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32,
+	0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x63,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x43, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x63,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x04, 0x61,
+	0x63, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x04, 0x61, 0x63, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x5f, 0x61, 0x63, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x41, 0x63, 0x6c, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x6d, 0x73,
+	0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x2e, 0x4d, 0x53, 0x50, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x04, 0x6d,
+	0x73, 0x70, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x41, 0x63, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x65, 0x0a, 0x11,
+	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x65, 0x65, 0x72, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x0c,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x50, 0x65, 0x65, 0x72, 0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x65,
+	0x65, 0x72, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72,
+	0x74, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6c, 0x73, 0x5f,
+	0x63, 0x65, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x22, 0xe4, 0x01, 0x0a, 0x0b, 0x52, 0x61,
+	0x66, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x63,
+	0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x69, 0x63, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x68, 0x65, 0x61,
+	0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61,
+	0x78, 0x5f, 0x69, 0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0xb7, 0x01, 0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x4f, 0x72, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x6f, 0x72, 0x67, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x67, 0x61,
-	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x4f,
-	0x72, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x6f, 0x72,
-	0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e,
-	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x70, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x48, 0x61, 0x73, 0x68, 0x12,
-	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x69, 0x67,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xe0, 0x01, 0x0a, 0x13, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75,
-	0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f,
-	0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
-	0x61, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x22, 0x75, 0x0a, 0x13,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x6f, 0x72, 0x67, 0x12, 0x19, 0x0a,
+	0x08, 0x6f, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0x95, 0x04, 0x0a, 0x08, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x72, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x65,
+	0x77, 0x4f, 0x72, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f,
+	0x6f, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x4f, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x43, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f,
+	0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x65, 0x65,
+	0x72, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x3a, 0x0a, 0x0c,
+	0x72, 0x61, 0x66, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x52, 0x61,
+	0x66, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x72, 0x61, 0x66,
+	0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0b, 0x6d, 0x61, 0x69, 0x6e,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x0b, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0b,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x6f, 0x72, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x65, 0x72, 0x4f, 0x72, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52,
+	0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x4f, 0x72, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x22, 0x70, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0xb8, 0x02, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0xe0, 0x01, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x6e, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12,
+	0x29, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65,
+	0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e,
+	0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x22, 0x75, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x69,
+	0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x12, 0x36, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52,
+	0x08, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x22, 0xd3, 0x01, 0x0a, 0x15, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x08, 0x65,
-	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x08, 0x65, 0x6e, 0x76, 0x65, 0x6c,
-	0x6f, 0x70, 0x65, 0x22, 0xd3, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
-	0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72,
-	0x12, 0x36, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x08,
-	0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x69, 0x67, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x52, 0x04, 0x73, 0x69, 0x67, 0x73, 0x2a, 0xc7, 0x01, 0x0a, 0x0c, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x6e,
-	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x10, 0x00,
-	0x12, 0x16, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x41, 0x64, 0x64, 0x50,
-	0x65, 0x65, 0x72, 0x4f, 0x72, 0x67, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x4f, 0x72,
-	0x67, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x10, 0x03, 0x12, 0x19, 0x0a,
-	0x15, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x5f, 0x41, 0x64, 0x64, 0x50,
-	0x65, 0x65, 0x72, 0x4f, 0x72, 0x67, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x73,
-	0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x65,
-	0x72, 0x4f, 0x72, 0x67, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
-	0x74, 0x69, 0x75, 0x6d, 0x5f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x10, 0x06, 0x32, 0xdc, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x53, 0x74, 0x75, 0x62, 0x12, 0x47, 0x0a, 0x08, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x65,
-	0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x61, 0x6c, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a,
-	0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
-	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e,
-	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x12, 0x1f, 0x2e,
-	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
-	0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x42, 0x13, 0x5a, 0x11, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x6e, 0x76, 0x65,
+	0x6c, 0x6f, 0x70, 0x65, 0x52, 0x08, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x2f,
+	0x0a, 0x04, 0x73, 0x69, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x04, 0x73, 0x69, 0x67, 0x73, 0x22,
+	0x8a, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x65, 0x72, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x22, 0xb6, 0x01, 0x0a,
+	0x13, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x65, 0x72, 0x22, 0x50, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x2a, 0xe1, 0x03, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x5f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65,
+	0x72, 0x4f, 0x72, 0x67, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x4f, 0x72, 0x67, 0x10,
+	0x02, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x43,
+	0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x5f, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65,
+	0x72, 0x4f, 0x72, 0x67, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
+	0x74, 0x69, 0x75, 0x6d, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x4f,
+	0x72, 0x67, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x74, 0x69,
+	0x75, 0x6d, 0x5f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x10,
+	0x06, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x53, 0x65, 0x74,
+	0x41, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x50, 0x65, 0x65, 0x72, 0x73, 0x10, 0x07, 0x12, 0x1a, 0x0a,
+	0x16, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x41, 0x64, 0x64, 0x41, 0x6e, 0x63, 0x68,
+	0x6f, 0x72, 0x50, 0x65, 0x65, 0x72, 0x73, 0x10, 0x08, 0x12, 0x1d, 0x0a, 0x19, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x50, 0x65, 0x65, 0x72, 0x73, 0x10, 0x09, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x65, 0x72, 0x5f, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x10, 0x0a, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x10, 0x0b, 0x12,
+	0x20, 0x0a, 0x1c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x73, 0x10,
+	0x0c, 0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x61, 0x66, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x10, 0x0d,
+	0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x53, 0x65, 0x74, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x10, 0x0e,
+	0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x5f, 0x41, 0x64, 0x64, 0x4f,
+	0x72, 0x67, 0x10, 0x0f, 0x12, 0x15, 0x0a, 0x11, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x72, 0x5f,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x67, 0x10, 0x10, 0x2a, 0xb9, 0x01, 0x0a, 0x0d,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x11, 0x0a,
+	0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00,
+	0x12, 0x13, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x52,
+	0x65, 0x61, 0x64, 0x79, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x10, 0x05,
+	0x12, 0x11, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0x07, 0x2a, 0x60, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x01,
+	0x12, 0x11, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x65,
+	0x72, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x43, 0x6f, 0x6e,
+	0x73, 0x6f, 0x72, 0x74, 0x69, 0x75, 0x6d, 0x10, 0x03, 0x32, 0xec, 0x02, 0x0a, 0x0c, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x75, 0x62, 0x12, 0x47, 0x0a, 0x08, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x04, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53,
+	0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x06, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x22, 0x00, 0x12,
+	0x47, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x13, 0x5a, 0x11, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x75, 0x74, 0x69, 0x6c, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -634,45 +2072,88 @@ func file_proposal_proto_rawDescGZIP() []byte {
 	return file_proposal_proto_rawDescData
 }
 
-var file_proposal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proposal_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proposal_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proposal_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_proposal_proto_goTypes = []interface{}{
 	(ProposalType)(0),             // 0: proposal.ProposalType
-	(*Proposal)(nil),              // 1: proposal.Proposal
-	(*ProposalSignature)(nil),     // 2: proposal.ProposalSignature
-	(*ProposalEnvelope)(nil),      // 3: proposal.ProposalEnvelope
-	(*ProposalInitRequest)(nil),   // 4: proposal.ProposalInitRequest
-	(*ProposalSignRequest)(nil),   // 5: proposal.ProposalSignRequest
-	(*ProposalSubmitRequest)(nil), // 6: proposal.ProposalSubmitRequest
-	(*Organization)(nil),          // 7: common.Organization
-	(*Signer)(nil),                // 8: common.Signer
-	(*Orderer)(nil),               // 9: common.Orderer
-	(*Response)(nil),              // 10: common.Response
+	(ProposalState)(0),            // 1: proposal.ProposalState
+	(ConfigGroup)(0),              // 2: proposal.ConfigGroup
+	(*BatchSize)(nil),             // 3: proposal.BatchSize
+	(*PolicyUpdate)(nil),          // 4: proposal.PolicyUpdate
+	(*CapabilityUpdate)(nil),      // 5: proposal.CapabilityUpdate
+	(*MSPUpdate)(nil),             // 6: proposal.MSPUpdate
+	(*ConfigModification)(nil),    // 7: proposal.ConfigModification
+	(*AnchorPeersUpdate)(nil),     // 8: proposal.AnchorPeersUpdate
+	(*Consenter)(nil),             // 9: proposal.Consenter
+	(*RaftOptions)(nil),           // 10: proposal.RaftOptions
+	(*OrdererOrgUpdate)(nil),      // 11: proposal.OrdererOrgUpdate
+	(*Proposal)(nil),              // 12: proposal.Proposal
+	(*ProposalSignature)(nil),     // 13: proposal.ProposalSignature
+	(*ProposalEnvelope)(nil),      // 14: proposal.ProposalEnvelope
+	(*ProposalInitRequest)(nil),   // 15: proposal.ProposalInitRequest
+	(*ProposalSignRequest)(nil),   // 16: proposal.ProposalSignRequest
+	(*ProposalSubmitRequest)(nil), // 17: proposal.ProposalSubmitRequest
+	(*ProposalQueryRequest)(nil),  // 18: proposal.ProposalQueryRequest
+	(*ProposalListRequest)(nil),   // 19: proposal.ProposalListRequest
+	(*ProposalListResponse)(nil),  // 20: proposal.ProposalListResponse
+	nil,                           // 21: proposal.ConfigModification.AclsEntry
+	(*AnchorPeer)(nil),            // 22: common.AnchorPeer
+	(*Organization)(nil),          // 23: common.Organization
+	(*Signer)(nil),                // 24: common.Signer
+	(*Orderer)(nil),               // 25: common.Orderer
+	(*Response)(nil),              // 26: common.Response
 }
 var file_proposal_proto_depIdxs = []int32{
-	0,  // 0: proposal.Proposal.type:type_name -> proposal.ProposalType
-	7,  // 1: proposal.Proposal.new_org:type_name -> common.Organization
-	2,  // 2: proposal.ProposalEnvelope.sign:type_name -> proposal.ProposalSignature
-	8,  // 3: proposal.ProposalInitRequest.signer:type_name -> common.Signer
-	9,  // 4: proposal.ProposalInitRequest.orderer:type_name -> common.Orderer
-	1,  // 5: proposal.ProposalInitRequest.proposal:type_name -> proposal.Proposal
-	8,  // 6: proposal.ProposalSignRequest.signer:type_name -> common.Signer
-	3,  // 7: proposal.ProposalSignRequest.envelope:type_name -> proposal.ProposalEnvelope
-	8,  // 8: proposal.ProposalSubmitRequest.signer:type_name -> common.Signer
-	9,  // 9: proposal.ProposalSubmitRequest.orderer:type_name -> common.Orderer
-	3,  // 10: proposal.ProposalSubmitRequest.envelope:type_name -> proposal.ProposalEnvelope
-	2,  // 11: proposal.ProposalSubmitRequest.sigs:type_name -> proposal.ProposalSignature
-	4,  // 12: proposal.ProposalStub.Initiate:input_type -> proposal.ProposalInitRequest
-	5,  // 13: proposal.ProposalStub.Sign:input_type -> proposal.ProposalSignRequest
-	6,  // 14: proposal.ProposalStub.Submit:input_type -> proposal.ProposalSubmitRequest
-	3,  // 15: proposal.ProposalStub.Initiate:output_type -> proposal.ProposalEnvelope
-	2,  // 16: proposal.ProposalStub.Sign:output_type -> proposal.ProposalSignature
-	10, // 17: proposal.ProposalStub.Submit:output_type -> common.Response
-	15, // [15:18] is the sub-list for method output_type
-	12, // [12:15] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	2,  // 0: proposal.PolicyUpdate.group:type_name -> proposal.ConfigGroup
+	2,  // 1: proposal.CapabilityUpdate.group:type_name -> proposal.ConfigGroup
+	2,  // 2: proposal.MSPUpdate.group:type_name -> proposal.ConfigGroup
+	3,  // 3: proposal.ConfigModification.batch_size:type_name -> proposal.BatchSize
+	4,  // 4: proposal.ConfigModification.policies:type_name -> proposal.PolicyUpdate
+	5,  // 5: proposal.ConfigModification.capabilities:type_name -> proposal.CapabilityUpdate
+	21, // 6: proposal.ConfigModification.acls:type_name -> proposal.ConfigModification.AclsEntry
+	6,  // 7: proposal.ConfigModification.msps:type_name -> proposal.MSPUpdate
+	22, // 8: proposal.AnchorPeersUpdate.anchor_peers:type_name -> common.AnchorPeer
+	23, // 9: proposal.OrdererOrgUpdate.org:type_name -> common.Organization
+	9,  // 10: proposal.OrdererOrgUpdate.consenters:type_name -> proposal.Consenter
+	0,  // 11: proposal.Proposal.type:type_name -> proposal.ProposalType
+	23, // 12: proposal.Proposal.new_org:type_name -> common.Organization
+	7,  // 13: proposal.Proposal.config_update:type_name -> proposal.ConfigModification
+	8,  // 14: proposal.Proposal.anchor_peers:type_name -> proposal.AnchorPeersUpdate
+	9,  // 15: proposal.Proposal.consenter:type_name -> proposal.Consenter
+	10, // 16: proposal.Proposal.raft_options:type_name -> proposal.RaftOptions
+	11, // 17: proposal.Proposal.orderer_org:type_name -> proposal.OrdererOrgUpdate
+	13, // 18: proposal.ProposalEnvelope.sign:type_name -> proposal.ProposalSignature
+	1,  // 19: proposal.ProposalEnvelope.state:type_name -> proposal.ProposalState
+	24, // 20: proposal.ProposalInitRequest.signer:type_name -> common.Signer
+	25, // 21: proposal.ProposalInitRequest.orderer:type_name -> common.Orderer
+	12, // 22: proposal.ProposalInitRequest.proposal:type_name -> proposal.Proposal
+	24, // 23: proposal.ProposalSignRequest.signer:type_name -> common.Signer
+	14, // 24: proposal.ProposalSignRequest.envelope:type_name -> proposal.ProposalEnvelope
+	24, // 25: proposal.ProposalSubmitRequest.signer:type_name -> common.Signer
+	25, // 26: proposal.ProposalSubmitRequest.orderer:type_name -> common.Orderer
+	14, // 27: proposal.ProposalSubmitRequest.envelope:type_name -> proposal.ProposalEnvelope
+	13, // 28: proposal.ProposalSubmitRequest.sigs:type_name -> proposal.ProposalSignature
+	24, // 29: proposal.ProposalQueryRequest.signer:type_name -> common.Signer
+	25, // 30: proposal.ProposalQueryRequest.orderer:type_name -> common.Orderer
+	1,  // 31: proposal.ProposalListRequest.state:type_name -> proposal.ProposalState
+	24, // 32: proposal.ProposalListRequest.signer:type_name -> common.Signer
+	25, // 33: proposal.ProposalListRequest.orderer:type_name -> common.Orderer
+	14, // 34: proposal.ProposalListResponse.proposals:type_name -> proposal.ProposalEnvelope
+	15, // 35: proposal.ProposalStub.Initiate:input_type -> proposal.ProposalInitRequest
+	16, // 36: proposal.ProposalStub.Sign:input_type -> proposal.ProposalSignRequest
+	17, // 37: proposal.ProposalStub.Submit:input_type -> proposal.ProposalSubmitRequest
+	18, // 38: proposal.ProposalStub.Query:input_type -> proposal.ProposalQueryRequest
+	19, // 39: proposal.ProposalStub.List:input_type -> proposal.ProposalListRequest
+	14, // 40: proposal.ProposalStub.Initiate:output_type -> proposal.ProposalEnvelope
+	13, // 41: proposal.ProposalStub.Sign:output_type -> proposal.ProposalSignature
+	26, // 42: proposal.ProposalStub.Submit:output_type -> common.Response
+	14, // 43: proposal.ProposalStub.Query:output_type -> proposal.ProposalEnvelope
+	20, // 44: proposal.ProposalStub.List:output_type -> proposal.ProposalListResponse
+	40, // [40:45] is the sub-list for method output_type
+	35, // [35:40] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_proposal_proto_init() }
@@ -683,7 +2164,7 @@ func file_proposal_proto_init() {
 	file_common_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_proposal_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Proposal); i {
+			switch v := v.(*BatchSize); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -695,7 +2176,7 @@ func file_proposal_proto_init() {
 			}
 		}
 		file_proposal_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProposalSignature); i {
+			switch v := v.(*PolicyUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -707,7 +2188,7 @@ func file_proposal_proto_init() {
 			}
 		}
 		file_proposal_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProposalEnvelope); i {
+			switch v := v.(*CapabilityUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -719,7 +2200,7 @@ func file_proposal_proto_init() {
 			}
 		}
 		file_proposal_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProposalInitRequest); i {
+			switch v := v.(*MSPUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -731,7 +2212,7 @@ func file_proposal_proto_init() {
 			}
 		}
 		file_proposal_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProposalSignRequest); i {
+			switch v := v.(*ConfigModification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -743,6 +2224,114 @@ func file_proposal_proto_init() {
 			}
 		}
 		file_proposal_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnchorPeersUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proposal_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Consenter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proposal_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RaftOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proposal_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrdererOrgUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proposal_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Proposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proposal_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposalSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proposal_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposalEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proposal_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposalInitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proposal_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposalSignRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proposal_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProposalSubmitRequest); i {
 			case 0:
 				return &v.state
@@ -754,18 +2343,60 @@ func file_proposal_proto_init() {
 				return nil
 			}
 		}
+		file_proposal_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposalQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proposal_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposalListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proposal_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProposalListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_proposal_proto_msgTypes[0].OneofWrappers = []interface{}{
+	file_proposal_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*Proposal_NewOrg)(nil),
 		(*Proposal_RemovedOrgName)(nil),
+		(*Proposal_ConfigUpdate)(nil),
+		(*Proposal_AnchorPeers)(nil),
+		(*Proposal_Consenter)(nil),
+		(*Proposal_RaftOptions)(nil),
+		(*Proposal_Maintenance)(nil),
+		(*Proposal_OrdererOrg)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proposal_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      3,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
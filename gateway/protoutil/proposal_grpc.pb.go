@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package protoutil
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ProposalStubClient is the client API for ProposalStub service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProposalStubClient interface {
+	// 发起提案
+	Initiate(ctx context.Context, in *ProposalInitRequest, opts ...grpc.CallOption) (*ProposalEnvelope, error)
+	// 提案签名
+	Sign(ctx context.Context, in *ProposalSignRequest, opts ...grpc.CallOption) (*ProposalSignature, error)
+	// 提交提案
+	Submit(ctx context.Context, in *ProposalSubmitRequest, opts ...grpc.CallOption) (*Response, error)
+	// 查询提案
+	Query(ctx context.Context, in *ProposalQueryRequest, opts ...grpc.CallOption) (*ProposalEnvelope, error)
+	// 提案列表
+	List(ctx context.Context, in *ProposalListRequest, opts ...grpc.CallOption) (*ProposalListResponse, error)
+}
+
+type proposalStubClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProposalStubClient(cc grpc.ClientConnInterface) ProposalStubClient {
+	return &proposalStubClient{cc}
+}
+
+func (c *proposalStubClient) Initiate(ctx context.Context, in *ProposalInitRequest, opts ...grpc.CallOption) (*ProposalEnvelope, error) {
+	out := new(ProposalEnvelope)
+	err := c.cc.Invoke(ctx, "/proposal.ProposalStub/Initiate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposalStubClient) Sign(ctx context.Context, in *ProposalSignRequest, opts ...grpc.CallOption) (*ProposalSignature, error) {
+	out := new(ProposalSignature)
+	err := c.cc.Invoke(ctx, "/proposal.ProposalStub/Sign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposalStubClient) Submit(ctx context.Context, in *ProposalSubmitRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/proposal.ProposalStub/Submit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposalStubClient) Query(ctx context.Context, in *ProposalQueryRequest, opts ...grpc.CallOption) (*ProposalEnvelope, error) {
+	out := new(ProposalEnvelope)
+	err := c.cc.Invoke(ctx, "/proposal.ProposalStub/Query", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proposalStubClient) List(ctx context.Context, in *ProposalListRequest, opts ...grpc.CallOption) (*ProposalListResponse, error) {
+	out := new(ProposalListResponse)
+	err := c.cc.Invoke(ctx, "/proposal.ProposalStub/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProposalStubServer is the server API for ProposalStub service.
+// All implementations must embed UnimplementedProposalStubServer
+// for forward compatibility
+type ProposalStubServer interface {
+	// 发起提案
+	Initiate(context.Context, *ProposalInitRequest) (*ProposalEnvelope, error)
+	// 提案签名
+	Sign(context.Context, *ProposalSignRequest) (*ProposalSignature, error)
+	// 提交提案
+	Submit(context.Context, *ProposalSubmitRequest) (*Response, error)
+	// 查询提案
+	Query(context.Context, *ProposalQueryRequest) (*ProposalEnvelope, error)
+	// 提案列表
+	List(context.Context, *ProposalListRequest) (*ProposalListResponse, error)
+	mustEmbedUnimplementedProposalStubServer()
+}
+
+// UnimplementedProposalStubServer must be embedded to have forward compatible implementations.
+type UnimplementedProposalStubServer struct {
+}
+
+func (UnimplementedProposalStubServer) Initiate(context.Context, *ProposalInitRequest) (*ProposalEnvelope, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Initiate not implemented")
+}
+func (UnimplementedProposalStubServer) Sign(context.Context, *ProposalSignRequest) (*ProposalSignature, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+func (UnimplementedProposalStubServer) Submit(context.Context, *ProposalSubmitRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Submit not implemented")
+}
+func (UnimplementedProposalStubServer) Query(context.Context, *ProposalQueryRequest) (*ProposalEnvelope, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (UnimplementedProposalStubServer) List(context.Context, *ProposalListRequest) (*ProposalListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedProposalStubServer) mustEmbedUnimplementedProposalStubServer() {}
+
+// UnsafeProposalStubServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProposalStubServer will
+// result in compilation errors.
+type UnsafeProposalStubServer interface {
+	mustEmbedUnimplementedProposalStubServer()
+}
+
+func RegisterProposalStubServer(s grpc.ServiceRegistrar, srv ProposalStubServer) {
+	s.RegisterService(&ProposalStub_ServiceDesc, srv)
+}
+
+func _ProposalStub_Initiate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalInitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalStubServer).Initiate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proposal.ProposalStub/Initiate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalStubServer).Initiate(ctx, req.(*ProposalInitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProposalStub_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalSignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalStubServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proposal.ProposalStub/Sign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalStubServer).Sign(ctx, req.(*ProposalSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProposalStub_Submit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalSubmitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalStubServer).Submit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proposal.ProposalStub/Submit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalStubServer).Submit(ctx, req.(*ProposalSubmitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProposalStub_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalStubServer).Query(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proposal.ProposalStub/Query",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalStubServer).Query(ctx, req.(*ProposalQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProposalStub_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposalListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalStubServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proposal.ProposalStub/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalStubServer).List(ctx, req.(*ProposalListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProposalStub_ServiceDesc is the grpc.ServiceDesc for ProposalStub service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProposalStub_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proposal.ProposalStub",
+	HandlerType: (*ProposalStubServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Initiate",
+			Handler:    _ProposalStub_Initiate_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _ProposalStub_Sign_Handler,
+		},
+		{
+			MethodName: "Submit",
+			Handler:    _ProposalStub_Submit_Handler,
+		},
+		{
+			MethodName: "Query",
+			Handler:    _ProposalStub_Query_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ProposalStub_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proposal.proto",
+}
@@ -0,0 +1,385 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/godzilla-s/fabricsdk-go/gateway"
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/comm"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+var (
+	jsonUnmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+	jsonMarshaler   = protojson.MarshalOptions{EmitUnpopulated: true}
+)
+
+type unaryCall func(ctx context.Context, req proto.Message) (proto.Message, error)
+
+type streamCall func(ctx context.Context, req proto.Message, send func(proto.Message) error) error
+
+// NewHTTPHandler returns a http.Handler which exposes every gateway operation as
+// a JSON endpoint. Request and response bodies are the protojson encoding of the
+// protoutil messages, binary fields are base64-encoded
+func NewHTTPHandler(maxBodySize int) http.Handler {
+	if maxBodySize == 0 {
+		maxBodySize = comm.MaxRecvMsgSize
+	}
+	ch := &channelServer{}
+	cc := &chaincodeServer{}
+	ct := &contractServer{}
+	ps := &proposalServer{}
+	es := &eventServer{}
+
+	mux := http.NewServeMux()
+	handle := func(path string, newReq func() proto.Message, call unaryCall) {
+		mux.Handle(path, &jsonHandler{newReq: newReq, call: call, maxBodySize: int64(maxBodySize)})
+	}
+	handleStream := func(path string, newReq func() proto.Message, call streamCall) {
+		mux.Handle(path, &streamHandler{newReq: newReq, call: call, maxBodySize: int64(maxBodySize)})
+	}
+
+	// channel
+	handle("/v1/channel/create", func() proto.Message { return &protoutil.CreateChannelRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ch.CreateChannel(ctx, req.(*protoutil.CreateChannelRequest))
+		})
+	handle("/v1/channel/join", func() proto.Message { return &protoutil.JoinChannelRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ch.JoinChannel(ctx, req.(*protoutil.JoinChannelRequest))
+		})
+	handle("/v1/channel/update", func() proto.Message { return &protoutil.UpdateChannelRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ch.UpdateChannel(ctx, req.(*protoutil.UpdateChannelRequest))
+		})
+	handle("/v1/channel/list", func() proto.Message { return &protoutil.ListChannelsRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ch.ListChannels(ctx, req.(*protoutil.ListChannelsRequest))
+		})
+	handle("/v1/channel/query-block", func() proto.Message { return &protoutil.QueryBlockRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ch.QueryBlock(ctx, req.(*protoutil.QueryBlockRequest))
+		})
+	handle("/v1/channel/block", func() proto.Message { return &protoutil.FetchBlockRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ch.FetchBlock(ctx, req.(*protoutil.FetchBlockRequest))
+		})
+	handle("/v1/channel/config", func() proto.Message { return &protoutil.FetchConfigRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ch.FetchConfig(ctx, req.(*protoutil.FetchConfigRequest))
+		})
+	handle("/v1/channel/info", func() proto.Message { return &protoutil.GetChannelInfoRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ch.GetChannelInfo(ctx, req.(*protoutil.GetChannelInfoRequest))
+		})
+
+	// chaincode
+	handle("/v1/chaincode/install", func() proto.Message { return &protoutil.ChaincodeInstallRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return cc.InstallChaincode(ctx, req.(*protoutil.ChaincodeInstallRequest))
+		})
+	handle("/v1/chaincode/approve", func() proto.Message { return &protoutil.ChaincodeApproveRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return cc.ApproveChaincode(ctx, req.(*protoutil.ChaincodeApproveRequest))
+		})
+	handle("/v1/chaincode/commit", func() proto.Message { return &protoutil.ChaincodeCommitRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return cc.CommitChaincode(ctx, req.(*protoutil.ChaincodeCommitRequest))
+		})
+	handle("/v1/chaincode/deploy", func() proto.Message { return &protoutil.ChaincodeDeployRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return cc.DeployChaincode(ctx, req.(*protoutil.ChaincodeDeployRequest))
+		})
+	handle("/v1/chaincode/installed", func() proto.Message { return &protoutil.ChaincodeQueryInstalledRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return cc.QueryInstalled(ctx, req.(*protoutil.ChaincodeQueryInstalledRequest))
+		})
+	handle("/v1/chaincode/package", func() proto.Message { return &protoutil.ChaincodeGetPackageRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return cc.GetInstalledPackage(ctx, req.(*protoutil.ChaincodeGetPackageRequest))
+		})
+	handle("/v1/chaincode/approved", func() proto.Message { return &protoutil.ChaincodeQueryApprovedRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return cc.QueryApproved(ctx, req.(*protoutil.ChaincodeQueryApprovedRequest))
+		})
+	handle("/v1/chaincode/committed", func() proto.Message { return &protoutil.ChaincodeQueryCommittedRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return cc.QueryCommitted(ctx, req.(*protoutil.ChaincodeQueryCommittedRequest))
+		})
+	handle("/v1/chaincode/readiness", func() proto.Message { return &protoutil.ChaincodeCheckReadinessRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return cc.CheckCommitReadiness(ctx, req.(*protoutil.ChaincodeCheckReadinessRequest))
+		})
+	handle("/v1/chaincode/status", func() proto.Message { return &protoutil.ChaincodeLifecycleStatusRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return cc.LifecycleStatus(ctx, req.(*protoutil.ChaincodeLifecycleStatusRequest))
+		})
+
+	// contract
+	handle("/v1/contract/invoke", func() proto.Message { return &protoutil.ContractInvokeRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ct.Invoke(ctx, req.(*protoutil.ContractInvokeRequest))
+		})
+	handle("/v1/contract/query", func() proto.Message { return &protoutil.ContractQueryRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ct.Query(ctx, req.(*protoutil.ContractQueryRequest))
+		})
+	handle("/v1/contract/submit", func() proto.Message { return &protoutil.ContractInvokeRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ct.Submit(ctx, req.(*protoutil.ContractInvokeRequest))
+		})
+	handle("/v1/contract/status", func() proto.Message { return &protoutil.ContractCommitStatusRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ct.CommitStatus(ctx, req.(*protoutil.ContractCommitStatusRequest))
+		})
+
+	// proposal
+	handle("/v1/proposal/initiate", func() proto.Message { return &protoutil.ProposalInitRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ps.Initiate(ctx, req.(*protoutil.ProposalInitRequest))
+		})
+	handle("/v1/proposal/sign", func() proto.Message { return &protoutil.ProposalSignRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ps.Sign(ctx, req.(*protoutil.ProposalSignRequest))
+		})
+	handle("/v1/proposal/submit", func() proto.Message { return &protoutil.ProposalSubmitRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ps.Submit(ctx, req.(*protoutil.ProposalSubmitRequest))
+		})
+	handle("/v1/proposal/query", func() proto.Message { return &protoutil.ProposalQueryRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ps.Query(ctx, req.(*protoutil.ProposalQueryRequest))
+		})
+	handle("/v1/proposal/list", func() proto.Message { return &protoutil.ProposalListRequest{} },
+		func(ctx context.Context, req proto.Message) (proto.Message, error) {
+			return ps.List(ctx, req.(*protoutil.ProposalListRequest))
+		})
+
+	// event
+	handleStream("/v1/event/chaincode", func() proto.Message { return &protoutil.ChaincodeEventsRequest{} },
+		func(ctx context.Context, req proto.Message, send func(proto.Message) error) error {
+			return es.chaincodeEvents(ctx, req.(*protoutil.ChaincodeEventsRequest), func(e *protoutil.ChaincodeEvent) error {
+				return send(e)
+			})
+		})
+	handleStream("/v1/event/blocks", func() proto.Message { return &protoutil.BlocksRequest{} },
+		func(ctx context.Context, req proto.Message, send func(proto.Message) error) error {
+			return es.blocks(ctx, req.(*protoutil.BlocksRequest), func(e *protoutil.BlockEvent) error {
+				return send(e)
+			})
+		})
+
+	return mux
+}
+
+type jsonHandler struct {
+	newReq      func() proto.Message
+	call        unaryCall
+	maxBodySize int64
+}
+
+func (h *jsonHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// like recoveryInterceptor for the grpc calls, a panic fails the request only
+	defer func() {
+		if p := recover(); p != nil {
+			writeError(w, http.StatusInternalServerError, codes.Internal, fmt.Sprintf("panic in %s: %v", r.URL.Path, p))
+		}
+	}()
+	req, ok := readRequest(w, r, h.newReq, h.maxBodySize)
+	if !ok {
+		return
+	}
+
+	resp, err := h.call(requestContext(r), req)
+	if err != nil {
+		st := status.Convert(err)
+		writeStatus(w, httpStatusFromCode(st.Code()), st)
+		return
+	}
+
+	data, err := jsonMarshaler.Marshal(resp)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, codes.Internal, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatusFromResponse(resp))
+	w.Write(data)
+}
+
+// requestContext marks the context of r with the caller authenticated by its tls
+// client certificate, see gateway.WithCaller
+func requestContext(r *http.Request) context.Context {
+	return gateway.WithCaller(r.Context(), tlsCaller(r.TLS))
+}
+
+// readRequest decodes the JSON body of a POST request, the error is written to
+// w if it fails
+func readRequest(w http.ResponseWriter, r *http.Request, newReq func() proto.Message, maxBodySize int64) (proto.Message, bool) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, codes.Unimplemented, "only POST is allowed")
+		return nil, false
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, codes.InvalidArgument, err.Error())
+		return nil, false
+	}
+	if int64(len(body)) > maxBodySize {
+		writeError(w, http.StatusRequestEntityTooLarge, codes.ResourceExhausted, "request body too large")
+		return nil, false
+	}
+
+	req := newReq()
+	if len(body) > 0 {
+		if err := jsonUnmarshaler.Unmarshal(body, req); err != nil {
+			writeError(w, http.StatusBadRequest, codes.InvalidArgument, "invalid request body: "+err.Error())
+			return nil, false
+		}
+	}
+	return req, true
+}
+
+// streamHandler serves a streaming call as newline-delimited JSON, one message
+// per line. An error after the first message is written as the last line, in
+// the same form as the error body of the unary calls
+type streamHandler struct {
+	newReq      func() proto.Message
+	call        streamCall
+	maxBodySize int64
+}
+
+func (h *streamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	req, ok := readRequest(w, r, h.newReq, h.maxBodySize)
+	if !ok {
+		return
+	}
+
+	flusher, _ := w.(http.Flusher)
+	started := false
+	err := h.call(requestContext(r), req, func(msg proto.Message) error {
+		data, err := jsonMarshaler.Marshal(msg)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if !started {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			w.WriteHeader(http.StatusOK)
+			started = true
+		}
+		if _, err := w.Write(append(data, '\n')); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+	if err == nil || r.Context().Err() != nil {
+		return
+	}
+	st := status.Convert(err)
+	if !started {
+		writeStatus(w, httpStatusFromCode(st.Code()), st)
+		return
+	}
+	data, _ := jsonMarshaler.Marshal(st.Proto())
+	w.Write(append(data, '\n'))
+}
+
+// httpStatusFromResponse maps the fabric status and error code carried by a
+// gateway response to a http status code. A failed fabric operation is reported
+// as a bad gateway unless its error code tells more, a chaincode rejecting its
+// input with a 4xx status is a bad request
+func httpStatusFromResponse(resp proto.Message) int {
+	r, ok := resp.(interface{ GetStatus() int32 })
+	if !ok {
+		return http.StatusOK
+	}
+	if s := r.GetStatus(); s == 0 || s >= 200 && s < 400 {
+		return http.StatusOK
+	}
+	e, ok := resp.(interface {
+		GetErrorCode() protoutil.ErrorCode
+		GetErrorDetails() []*protoutil.ErrorDetail
+	})
+	if !ok {
+		return http.StatusBadGateway
+	}
+	switch e.GetErrorCode() {
+	case protoutil.ErrorCode_POLICY_FAILURE:
+		return http.StatusForbidden
+	case protoutil.ErrorCode_TIMEOUT:
+		return http.StatusGatewayTimeout
+	case protoutil.ErrorCode_MVCC_CONFLICT:
+		return http.StatusConflict
+	case protoutil.ErrorCode_CONNECTION_FAILURE:
+		return http.StatusServiceUnavailable
+	case protoutil.ErrorCode_ENDORSEMENT_FAILURE:
+		if invalidInput(e.GetErrorDetails()) {
+			return http.StatusBadRequest
+		}
+	}
+	return http.StatusBadGateway
+}
+
+// invalidInput reports whether every failed peer answered with a 4xx status
+func invalidInput(details []*protoutil.ErrorDetail) bool {
+	for _, d := range details {
+		if d.Status < 400 || d.Status >= 500 {
+			return false
+		}
+	}
+	return len(details) > 0
+}
+
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Canceled:
+		return 499 // client closed request
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeError(w http.ResponseWriter, httpStatus int, code codes.Code, message string) {
+	writeStatus(w, httpStatus, status.New(code, message))
+}
+
+// writeStatus writes the status as JSON, including its error details
+func writeStatus(w http.ResponseWriter, httpStatus int, st *status.Status) {
+	data, _ := jsonMarshaler.Marshal(st.Proto())
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	w.Write(data)
+}
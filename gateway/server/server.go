@@ -0,0 +1,308 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"github.com/godzilla-s/fabricsdk-go/gateway"
+	"github.com/godzilla-s/fabricsdk-go/gateway/proposalstore"
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/comm"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"io/ioutil"
+	"net"
+	"net/http"
+)
+
+// Config defines the parameters for serving the gateway services
+type Config struct {
+	// ListenAddress is the address the grpc server listens on, e.g. 0.0.0.0:7060
+	ListenAddress string
+	// HTTPListenAddress is the address the HTTP/JSON front-end listens on, the
+	// front-end is disabled if empty
+	HTTPListenAddress string
+	// TLSCertFile and TLSKeyFile are the PEM-encoded server certificate and key.
+	// TLS is disabled when both of them are empty
+	TLSCertFile string
+	TLSKeyFile  string
+	// TLSClientCAFile is the PEM-encoded CA certificates of the clients. When it is
+	// set every client must present a certificate issued by them, and the Common
+	// Name of the certificate is the caller checked by the wallet access list
+	TLSClientCAFile string
+	// MaxRecvMsgSize is the maximum message size the server can receive
+	MaxRecvMsgSize int
+	// MaxSendMsgSize is the maximum message size the server can send
+	MaxSendMsgSize int
+}
+
+// Server serves the gateway services (ChannelStub, ChaincodeStub, ContractStub,
+// ProposalStub and EventStub) over grpc, and optionally over HTTP/JSON
+type Server struct {
+	listener     net.Listener
+	grpcServer   *grpc.Server
+	httpListener net.Listener
+	httpServer   *http.Server
+}
+
+// New creates a gateway server listening on the configured address
+func New(config Config) (*Server, error) {
+	if config.ListenAddress == "" {
+		return nil, errors.New("listen address is required")
+	}
+	if config.MaxRecvMsgSize == 0 {
+		config.MaxRecvMsgSize = comm.MaxRecvMsgSize
+	}
+	if config.MaxSendMsgSize == 0 {
+		config.MaxSendMsgSize = comm.MaxSendMsgSize
+	}
+
+	serverOpts := []grpc.ServerOption{
+		grpc.MaxRecvMsgSize(config.MaxRecvMsgSize),
+		grpc.MaxSendMsgSize(config.MaxSendMsgSize),
+		grpc.ChainUnaryInterceptor(recoveryInterceptor, callerInterceptor),
+		grpc.ChainStreamInterceptor(streamRecoveryInterceptor, streamCallerInterceptor),
+	}
+	if config.TLSClientCAFile != "" && config.TLSCertFile == "" {
+		return nil, errors.New("client ca certificates require tls")
+	}
+	var tlsConfig *tls.Config
+	if config.TLSCertFile != "" || config.TLSKeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(config.TLSCertFile, config.TLSKeyFile)
+		if err != nil {
+			return nil, errors.WithMessage(err, "load server tls certificate")
+		}
+		tlsConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+			CipherSuites: comm.DefaultTLSCipherSuites,
+		}
+		if config.TLSClientCAFile != "" {
+			pem, err := ioutil.ReadFile(config.TLSClientCAFile)
+			if err != nil {
+				return nil, errors.Wrap(err, "read client ca certificates")
+			}
+			clientCAs := x509.NewCertPool()
+			if !clientCAs.AppendCertsFromPEM(pem) {
+				return nil, errors.Errorf("no certificate in %s", config.TLSClientCAFile)
+			}
+			tlsConfig.ClientCAs = clientCAs
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	listener, err := net.Listen("tcp", config.ListenAddress)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "listen on %s", config.ListenAddress)
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
+	Register(grpcServer)
+
+	s := &Server{
+		listener:   listener,
+		grpcServer: grpcServer,
+	}
+
+	if config.HTTPListenAddress != "" {
+		httpListener, err := net.Listen("tcp", config.HTTPListenAddress)
+		if err != nil {
+			listener.Close()
+			return nil, errors.WithMessagef(err, "listen on %s", config.HTTPListenAddress)
+		}
+		if tlsConfig != nil {
+			httpListener = tls.NewListener(httpListener, tlsConfig)
+		}
+		s.httpListener = httpListener
+		s.httpServer = &http.Server{Handler: NewHTTPHandler(config.MaxRecvMsgSize)}
+	}
+
+	return s, nil
+}
+
+// Register registers all gateway services on the grpc server
+func Register(s *grpc.Server) {
+	protoutil.RegisterChannelStubServer(s, &channelServer{})
+	protoutil.RegisterChaincodeStubServer(s, &chaincodeServer{})
+	protoutil.RegisterContractStubServer(s, &contractServer{})
+	protoutil.RegisterProposalStubServer(s, &proposalServer{})
+	protoutil.RegisterEventStubServer(s, &eventServer{})
+}
+
+// Address returns the address the server is listening on
+func (s *Server) Address() string {
+	return s.listener.Addr().String()
+}
+
+// HTTPAddress returns the address the HTTP/JSON front-end is listening on, it
+// is empty if the front-end is disabled
+func (s *Server) HTTPAddress() string {
+	if s.httpListener == nil {
+		return ""
+	}
+	return s.httpListener.Addr().String()
+}
+
+// Start serves the gateway services, it blocks until the server is stopped
+func (s *Server) Start() error {
+	if s.httpServer == nil {
+		return s.grpcServer.Serve(s.listener)
+	}
+
+	errCh := make(chan error, 2)
+	go func() {
+		errCh <- s.grpcServer.Serve(s.listener)
+	}()
+	go func() {
+		err := s.httpServer.Serve(s.httpListener)
+		if err == http.ErrServerClosed {
+			err = nil
+		}
+		errCh <- err
+	}()
+
+	// stop both servers if either of them fails
+	err := <-errCh
+	if err != nil {
+		s.Stop()
+	}
+	if err2 := <-errCh; err == nil {
+		err = err2
+	}
+	return err
+}
+
+// Stop gracefully stops the server
+func (s *Server) Stop() {
+	if s.httpServer != nil {
+		s.httpServer.Shutdown(context.Background())
+	}
+	s.grpcServer.GracefulStop()
+	gateway.CloseConnections()
+}
+
+// recoveryInterceptor converts a panic in a handler into an Internal error, so
+// that a malformed request cannot bring the whole gateway down
+func recoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = status.Errorf(codes.Internal, "panic in %s: %v", info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+// streamRecoveryInterceptor is the recoveryInterceptor of streaming calls
+func streamRecoveryInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = status.Errorf(codes.Internal, "panic in %s: %v", info.FullMethod, r)
+		}
+	}()
+	return handler(srv, ss)
+}
+
+// callerInterceptor marks the context of every call with the caller
+// authenticated by its tls client certificate, see gateway.WithCaller
+func callerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(gateway.WithCaller(ctx, grpcCaller(ctx)), req)
+}
+
+// streamCallerInterceptor is the callerInterceptor of streaming calls
+func streamCallerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := gateway.WithCaller(ss.Context(), grpcCaller(ss.Context()))
+	return handler(srv, &callerStream{ServerStream: ss, ctx: ctx})
+}
+
+type callerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *callerStream) Context() context.Context {
+	return s.ctx
+}
+
+// grpcCaller returns the caller of a grpc call, empty if it is not authenticated
+func grpcCaller(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return ""
+	}
+	return tlsCaller(&info.State)
+}
+
+// tlsCaller returns the Common Name of the verified client certificate, empty
+// if the client did not present a certificate verified by the client CAs
+func tlsCaller(state *tls.ConnectionState) string {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return state.VerifiedChains[0][0].Subject.CommonName
+}
+
+// toStatus converts an error returned by the gateway into a grpc status error
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, proposalstore.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, proposalstore.ErrExpired), errors.Is(err, proposalstore.ErrClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, gateway.ErrIdentityDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	code, details := gateway.ErrorDetails(err)
+	st := status.New(codeFromError(code), err.Error())
+	if len(details) > 0 {
+		msgs := make([]protoiface.MessageV1, len(details))
+		for i, d := range details {
+			msgs[i] = d
+		}
+		if withDetails, err := st.WithDetails(msgs...); err == nil {
+			st = withDetails
+		}
+	}
+	return st.Err()
+}
+
+// codeFromError maps the error code of the sdk to a grpc code
+func codeFromError(code protoutil.ErrorCode) codes.Code {
+	switch code {
+	case protoutil.ErrorCode_NO_ERROR:
+		return codes.OK
+	case protoutil.ErrorCode_ENDORSEMENT_FAILURE, protoutil.ErrorCode_PROPOSAL_MISMATCH,
+		protoutil.ErrorCode_VALIDATION_FAILURE, protoutil.ErrorCode_MVCC_CONFLICT:
+		return codes.Aborted
+	case protoutil.ErrorCode_ORDERER_NACK:
+		return codes.FailedPrecondition
+	case protoutil.ErrorCode_POLICY_FAILURE:
+		return codes.PermissionDenied
+	case protoutil.ErrorCode_TIMEOUT:
+		return codes.DeadlineExceeded
+	case protoutil.ErrorCode_CONNECTION_FAILURE:
+		return codes.Unavailable
+	default:
+		return codes.Unknown
+	}
+}
+
+func missingField(name string) error {
+	return status.Error(codes.InvalidArgument, fmt.Sprintf("missing required field: %s", name))
+}
@@ -0,0 +1,438 @@
+package server
+
+import (
+	"context"
+	"github.com/godzilla-s/fabricsdk-go/gateway"
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+)
+
+// channelServer implements protoutil.ChannelStubServer
+type channelServer struct {
+	protoutil.UnimplementedChannelStubServer
+}
+
+func (s *channelServer) CreateChannel(ctx context.Context, req *protoutil.CreateChannelRequest) (*protoutil.Response, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Orderer == nil {
+		return nil, missingField("orderer")
+	}
+	resp, err := gateway.ChannelCreate(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *channelServer) JoinChannel(ctx context.Context, req *protoutil.JoinChannelRequest) (*protoutil.JoinChannelResponse, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Orderer == nil {
+		return nil, missingField("orderer")
+	}
+	if len(req.Peers) == 0 {
+		return nil, missingField("peers")
+	}
+	resp, err := gateway.ChannelJoin(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *channelServer) UpdateChannel(ctx context.Context, req *protoutil.UpdateChannelRequest) (*protoutil.Response, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Orderer == nil {
+		return nil, missingField("orderer")
+	}
+	resp, err := gateway.ChannelUpdate(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *channelServer) QueryBlock(ctx context.Context, req *protoutil.QueryBlockRequest) (*protoutil.QueryBlockResponse, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Peer == nil {
+		return nil, missingField("peer")
+	}
+	if req.Condition == nil {
+		return nil, missingField("condition")
+	}
+	resp, err := gateway.QueryBlock(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *channelServer) FetchBlock(ctx context.Context, req *protoutil.FetchBlockRequest) (*protoutil.FetchBlockResponse, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Orderer == nil {
+		return nil, missingField("orderer")
+	}
+	resp, err := gateway.FetchBlock(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *channelServer) FetchConfig(ctx context.Context, req *protoutil.FetchConfigRequest) (*protoutil.FetchConfigResponse, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Orderer == nil {
+		return nil, missingField("orderer")
+	}
+	resp, err := gateway.FetchConfig(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *channelServer) ListChannels(ctx context.Context, req *protoutil.ListChannelsRequest) (*protoutil.ListChannelsResponse, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Peer == nil {
+		return nil, missingField("peer")
+	}
+	resp, err := gateway.ChannelList(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *channelServer) GetChannelInfo(ctx context.Context, req *protoutil.GetChannelInfoRequest) (*protoutil.GetChannelInfoResponse, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Peer == nil {
+		return nil, missingField("peer")
+	}
+	if req.ChannelId == "" {
+		return nil, missingField("channel_id")
+	}
+	resp, err := gateway.GetChannelInfo(ctx, req)
+	return resp, toStatus(err)
+}
+
+// chaincodeServer implements protoutil.ChaincodeStubServer
+type chaincodeServer struct {
+	protoutil.UnimplementedChaincodeStubServer
+}
+
+func (s *chaincodeServer) InstallChaincode(ctx context.Context, req *protoutil.ChaincodeInstallRequest) (*protoutil.ChaincodeInstallResponse, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Chaincode == nil {
+		return nil, missingField("chaincode")
+	}
+	resp, err := gateway.ChaincodeInstall(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *chaincodeServer) ApproveChaincode(ctx context.Context, req *protoutil.ChaincodeApproveRequest) (*protoutil.Response, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Committer == nil {
+		return nil, missingField("committer")
+	}
+	if req.Orderer == nil {
+		return nil, missingField("orderer")
+	}
+	if req.Definition == nil {
+		return nil, missingField("definition")
+	}
+	resp, err := gateway.ChaincodeApprove(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *chaincodeServer) CommitChaincode(ctx context.Context, req *protoutil.ChaincodeCommitRequest) (*protoutil.Response, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Orderer == nil {
+		return nil, missingField("orderer")
+	}
+	if req.Definition == nil {
+		return nil, missingField("definition")
+	}
+	resp, err := gateway.ChaincodeCommit(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *chaincodeServer) DeployChaincode(ctx context.Context, req *protoutil.ChaincodeDeployRequest) (*protoutil.ChaincodeDeployResponse, error) {
+	if req.Chaincode == nil {
+		return nil, missingField("chaincode")
+	}
+	if len(req.Orgs) == 0 {
+		return nil, missingField("orgs")
+	}
+	if req.Orderer == nil {
+		return nil, missingField("orderer")
+	}
+	if req.Definition == nil {
+		return nil, missingField("definition")
+	}
+	if req.ChannelId == "" {
+		return nil, missingField("channel_id")
+	}
+	resp, err := gateway.ChaincodeDeploy(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *chaincodeServer) QueryInstalled(ctx context.Context, req *protoutil.ChaincodeQueryInstalledRequest) (*protoutil.ChaincodeQueryInstalledResponse, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Peer == nil {
+		return nil, missingField("peer")
+	}
+	resp, err := gateway.ChaincodeQueryInstalled(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *chaincodeServer) GetInstalledPackage(ctx context.Context, req *protoutil.ChaincodeGetPackageRequest) (*protoutil.ChaincodeGetPackageResponse, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Peer == nil {
+		return nil, missingField("peer")
+	}
+	if req.PackageId == "" {
+		return nil, missingField("package_id")
+	}
+	resp, err := gateway.ChaincodeGetInstalledPackage(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *chaincodeServer) QueryApproved(ctx context.Context, req *protoutil.ChaincodeQueryApprovedRequest) (*protoutil.ChaincodeQueryApprovedResponse, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Peer == nil {
+		return nil, missingField("peer")
+	}
+	if req.ChannelId == "" {
+		return nil, missingField("channel_id")
+	}
+	if req.Name == "" {
+		return nil, missingField("name")
+	}
+	resp, err := gateway.ChaincodeQueryApproved(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *chaincodeServer) QueryCommitted(ctx context.Context, req *protoutil.ChaincodeQueryCommittedRequest) (*protoutil.ChaincodeQueryCommittedResponse, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Peer == nil {
+		return nil, missingField("peer")
+	}
+	if req.ChannelId == "" {
+		return nil, missingField("channel_id")
+	}
+	resp, err := gateway.ChaincodeQueryCommitted(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *chaincodeServer) CheckCommitReadiness(ctx context.Context, req *protoutil.ChaincodeCheckReadinessRequest) (*protoutil.ChaincodeCheckReadinessResponse, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Peer == nil {
+		return nil, missingField("peer")
+	}
+	if req.ChannelId == "" {
+		return nil, missingField("channel_id")
+	}
+	if req.Definition == nil {
+		return nil, missingField("definition")
+	}
+	resp, err := gateway.ChaincodeCheckCommitReadiness(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *chaincodeServer) LifecycleStatus(ctx context.Context, req *protoutil.ChaincodeLifecycleStatusRequest) (*protoutil.ChaincodeLifecycleStatusResponse, error) {
+	if len(req.Orgs) == 0 {
+		return nil, missingField("orgs")
+	}
+	if req.ChannelId == "" {
+		return nil, missingField("channel_id")
+	}
+	if req.Name == "" {
+		return nil, missingField("name")
+	}
+	resp, err := gateway.ChaincodeLifecycleStatus(ctx, req)
+	return resp, toStatus(err)
+}
+
+// contractServer implements protoutil.ContractStubServer
+type contractServer struct {
+	protoutil.UnimplementedContractStubServer
+}
+
+func (s *contractServer) Invoke(ctx context.Context, req *protoutil.ContractInvokeRequest) (*protoutil.Response, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Committer == nil {
+		return nil, missingField("committer")
+	}
+	if req.Orderer == nil {
+		return nil, missingField("orderer")
+	}
+	if req.Args == nil {
+		return nil, missingField("args")
+	}
+	resp, err := gateway.ChaincodeInvoke(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *contractServer) Query(ctx context.Context, req *protoutil.ContractQueryRequest) (*protoutil.Response, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Committer == nil {
+		return nil, missingField("committer")
+	}
+	if req.Orderer == nil {
+		return nil, missingField("orderer")
+	}
+	if req.Args == nil {
+		return nil, missingField("args")
+	}
+	resp, err := gateway.ChaincodeQuery(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *contractServer) Submit(ctx context.Context, req *protoutil.ContractInvokeRequest) (*protoutil.ContractSubmitResponse, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Committer == nil {
+		return nil, missingField("committer")
+	}
+	if req.Orderer == nil {
+		return nil, missingField("orderer")
+	}
+	if req.Args == nil {
+		return nil, missingField("args")
+	}
+	resp, err := gateway.ChaincodeSubmit(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *contractServer) CommitStatus(ctx context.Context, req *protoutil.ContractCommitStatusRequest) (*protoutil.ContractCommitStatusResponse, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Peer == nil {
+		return nil, missingField("peer")
+	}
+	if req.ChannelId == "" {
+		return nil, missingField("channel_id")
+	}
+	if req.TxId == "" {
+		return nil, missingField("tx_id")
+	}
+	resp, err := gateway.ChaincodeCommitStatus(ctx, req)
+	return resp, toStatus(err)
+}
+
+// proposalServer implements protoutil.ProposalStubServer
+type proposalServer struct {
+	protoutil.UnimplementedProposalStubServer
+}
+
+func (s *proposalServer) Initiate(ctx context.Context, req *protoutil.ProposalInitRequest) (*protoutil.ProposalEnvelope, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Orderer == nil {
+		return nil, missingField("orderer")
+	}
+	if req.Proposal == nil {
+		return nil, missingField("proposal")
+	}
+	resp, err := gateway.ProposalInitiate(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *proposalServer) Sign(ctx context.Context, req *protoutil.ProposalSignRequest) (*protoutil.ProposalSignature, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Envelope == nil {
+		return nil, missingField("envelope")
+	}
+	resp, err := gateway.ProposalSign(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *proposalServer) Submit(ctx context.Context, req *protoutil.ProposalSubmitRequest) (*protoutil.Response, error) {
+	if req.Signer == nil {
+		return nil, missingField("signer")
+	}
+	if req.Orderer == nil {
+		return nil, missingField("orderer")
+	}
+	if req.Envelope == nil || (len(req.Envelope.ProposalId) == 0 && req.Envelope.Sign == nil) {
+		return nil, missingField("envelope")
+	}
+	resp, err := gateway.ProposalSubmit(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *proposalServer) Query(ctx context.Context, req *protoutil.ProposalQueryRequest) (*protoutil.ProposalEnvelope, error) {
+	if len(req.ProposalId) == 0 {
+		return nil, missingField("proposal_id")
+	}
+	resp, err := gateway.ProposalQuery(ctx, req)
+	return resp, toStatus(err)
+}
+
+func (s *proposalServer) List(ctx context.Context, req *protoutil.ProposalListRequest) (*protoutil.ProposalListResponse, error) {
+	resp, err := gateway.ProposalList(ctx, req)
+	return resp, toStatus(err)
+}
+
+// eventServer implements protoutil.EventStubServer
+type eventServer struct {
+	protoutil.UnimplementedEventStubServer
+}
+
+func (s *eventServer) ChaincodeEvents(req *protoutil.ChaincodeEventsRequest, stream protoutil.EventStub_ChaincodeEventsServer) error {
+	return s.chaincodeEvents(stream.Context(), req, stream.Send)
+}
+
+// chaincodeEvents is shared by the grpc and the HTTP front-end
+func (s *eventServer) chaincodeEvents(ctx context.Context, req *protoutil.ChaincodeEventsRequest, send func(*protoutil.ChaincodeEvent) error) error {
+	if req.Signer == nil {
+		return missingField("signer")
+	}
+	if req.Peer == nil {
+		return missingField("peer")
+	}
+	if req.ChannelId == "" {
+		return missingField("channel_id")
+	}
+	if req.ChaincodeName == "" {
+		return missingField("chaincode_name")
+	}
+	return toStatus(gateway.ChaincodeEvents(ctx, req, send))
+}
+
+func (s *eventServer) Blocks(req *protoutil.BlocksRequest, stream protoutil.EventStub_BlocksServer) error {
+	return s.blocks(stream.Context(), req, stream.Send)
+}
+
+// blocks is shared by the grpc and the HTTP front-end. The checkpoint of the
+// subscription is kept in the checkpoint dir of the gateway, see gateway.SetCheckpointDir
+func (s *eventServer) blocks(ctx context.Context, req *protoutil.BlocksRequest, send func(*protoutil.BlockEvent) error) error {
+	if req.Signer == nil {
+		return missingField("signer")
+	}
+	if len(req.Peers) == 0 {
+		return missingField("peers")
+	}
+	if req.ChannelId == "" {
+		return missingField("channel_id")
+	}
+	return toStatus(gateway.Blocks(ctx, req, nil, send))
+}
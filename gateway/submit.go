@@ -0,0 +1,174 @@
+package gateway
+
+import (
+	"context"
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/chaincode"
+	"github.com/godzilla-s/fabricsdk-go/internal/chaincode/contract"
+	"github.com/godzilla-s/fabricsdk-go/internal/channel"
+	peercli "github.com/godzilla-s/fabricsdk-go/internal/client/peer"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/event"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/pkg/errors"
+	"strings"
+	"time"
+)
+
+// commitNotifierIdleTimeout 空闲的提交通知器保留的时间
+const commitNotifierIdleTimeout = 5 * time.Minute
+
+// commitNotifiers 按节点、通道和签名者的组织共享提交通知器，等待交易提交时复用同一个 DeliverFiltered 连接
+var commitNotifiers = chaincode.NewCommitNotifierPool(commitNotifierIdleTimeout)
+
+// ChaincodeSubmit 调用链码，交易发送给排序节点后即返回，通过 ChaincodeCommitStatus 获取提交结果
+func ChaincodeSubmit(ctx context.Context, req *protoutil.ContractInvokeRequest) (*protoutil.ContractSubmitResponse, error) {
+	signer, err := createSigner(ctx, req.Signer)
+	if err != nil {
+		return nil, errors.WithMessage(err, "get signer")
+	}
+	commonFactory, err := createCommonFactory(ctx, req.Committer, req.Endorsers, req.Orderer)
+	if err != nil {
+		return nil, errors.WithMessage(err, "get common factory")
+	}
+	defer commonFactory.Close()
+	c, err := contract.New(ctx, signer, req.Args.Name, req.Args.Version, req.ChannelId, commonFactory)
+	if err != nil {
+		return nil, errors.WithMessage(err, "new contract")
+	}
+	// 在发送之前取得提交节点上共享的通知器，通知器会缓存交易的提交结果，
+	// 之后对该节点调用 ChaincodeCommitStatus 时不需要再查询账本
+	notifier, err := commitNotifier(ctx, signer, req.Committer, req.ChannelId)
+	if err != nil {
+		return nil, errors.WithMessage(err, "get commit notifier")
+	}
+	tx, err := c.Submit(ctx, req.Args.Args, notifier)
+	if err != nil {
+		return nil, errors.WithMessage(err, "submit")
+	}
+	defer tx.Cancel()
+	resp := tx.Response()
+	return &protoutil.ContractSubmitResponse{
+		TxId:    tx.TxID(),
+		Status:  resp.Status,
+		Message: resp.Message,
+		Payload: resp.Payload,
+	}, nil
+}
+
+// ChaincodeCommitStatus 等待交易提交，返回交易的验证结果和所在区块，直到 ctx 结束
+func ChaincodeCommitStatus(ctx context.Context, req *protoutil.ContractCommitStatusRequest) (*protoutil.ContractCommitStatusResponse, error) {
+	signer, err := createSigner(ctx, req.Signer)
+	if err != nil {
+		return nil, err
+	}
+	pClient, err := newPeerClient(req.Peer)
+	if err != nil {
+		return nil, err
+	}
+	defer pClient.Close()
+
+	// 先注册再查账本：之前提交的交易在账本中，之后提交的由通知器通知
+	notifier, err := commitNotifier(ctx, signer, req.Peer, req.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+	tx := chaincode.NewTransaction(req.TxId, notifier)
+	defer tx.Cancel()
+	var status *chaincode.CommitStatus
+	select {
+	case <-tx.Done():
+	default:
+		if status, err = committedStatus(ctx, signer, pClient, req.ChannelId, req.TxId); err != nil {
+			return nil, err
+		}
+		if status != nil {
+			status.Peer = pClient.GetAddress()
+		}
+	}
+	if status == nil {
+		if status, err = tx.Status(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &protoutil.ContractCommitStatusResponse{
+		TxId:           status.TxID,
+		ValidationCode: int32(status.ValidationCode),
+		BlockNumber:    status.BlockNumber,
+	}
+	if err := status.Err(); err != nil {
+		resp.ErrorCode, _ = ErrorDetails(err)
+		resp.Message = err.Error()
+	}
+	return resp, nil
+}
+
+// commitNotifier 返回节点 p 上通道 channelID 共享的提交通知器，新建的通知器从通道当前的高度开始监听
+func commitNotifier(ctx context.Context, signer cryptoutil.Signer, p *protoutil.Peer, channelID string) (chaincode.CommitNotifier, error) {
+	p, err := resolvePeer(p)
+	if err != nil {
+		return nil, err
+	}
+	key := strings.Join([]string{p.Url, p.HostName, channelID, signer.GetMSPId()}, "|")
+	return commitNotifiers.Get(key, func() (*chaincode.DeliverCommitNotifier, func(), error) {
+		pClient, err := newPeerClient(p)
+		if err != nil {
+			return nil, nil, err
+		}
+		info, err := channel.GetInfo(ctx, signer, pClient, channelID)
+		if err != nil {
+			pClient.Close()
+			return nil, nil, errors.WithMessage(err, "get channel info")
+		}
+		notifier, err := chaincode.NewDeliverCommitNotifier(signer, []event.DeliverPeer{pClient}, channelID, event.Start{Type: event.StartBlock, BlockNumber: info.Height})
+		if err != nil {
+			pClient.Close()
+			return nil, nil, err
+		}
+		return notifier, func() { pClient.Close() }, nil
+	})
+}
+
+// commitNotifiersOf 返回每个节点的提交通知器
+func commitNotifiersOf(ctx context.Context, signer cryptoutil.Signer, peers []*protoutil.Peer, channelID string) ([]chaincode.CommitNotifier, error) {
+	notifiers := make([]chaincode.CommitNotifier, len(peers))
+	for i, p := range peers {
+		notifier, err := commitNotifier(ctx, signer, p, channelID)
+		if err != nil {
+			return nil, err
+		}
+		notifiers[i] = notifier
+	}
+	return notifiers, nil
+}
+
+// setCommitNotifiers 让 cf 通过节点上共享的提交通知器等待交易提交，不再为每个交易建立 DeliverFiltered 连接
+func setCommitNotifiers(ctx context.Context, cf *chaincode.CommonFactory, signer cryptoutil.Signer, peers []*protoutil.Peer, channelID string) error {
+	notifiers, err := commitNotifiersOf(ctx, signer, peers, channelID)
+	if err != nil {
+		return errors.WithMessage(err, "get commit notifiers")
+	}
+	cf.Notifiers = notifiers
+	return nil
+}
+
+// committedStatus 从账本中查询已提交的交易，交易不存在时返回nil
+func committedStatus(ctx context.Context, signer cryptoutil.Signer, pClient peercli.Client, channelID, txID string) (*chaincode.CommitStatus, error) {
+	tx, err := channel.GetTransactionByID(ctx, signer, pClient, channelID, txID)
+	if err != nil {
+		if errors.Is(err, channel.ErrTxNotFound) {
+			return nil, nil
+		}
+		return nil, errors.WithMessage(err, "get transaction")
+	}
+	block, err := channel.GetBlockByTxID(ctx, signer, pClient, channelID, txID)
+	if err != nil {
+		return nil, errors.WithMessage(err, "get block of transaction")
+	}
+	return &chaincode.CommitStatus{
+		TxID:           txID,
+		ValidationCode: peer.TxValidationCode(tx.ValidationCode),
+		BlockNumber:    block.GetHeader().GetNumber(),
+	}, nil
+}
@@ -13,4 +13,5 @@ require (
 	golang.org/x/crypto v0.0.0-20210921155107-089bfa567519
 	google.golang.org/grpc v1.41.0
 	google.golang.org/protobuf v1.26.0
+	gopkg.in/yaml.v2 v2.2.4
 )
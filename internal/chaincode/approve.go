@@ -5,6 +5,7 @@ import (
 	"github.com/godzilla-s/fabricsdk-go/internal/chaincode/policy"
 	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
 	"github.com/godzilla-s/fabricsdk-go/internal/utils"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
 	"github.com/golang/protobuf/proto"
 	cb "github.com/hyperledger/fabric-protos-go/common"
 	pb "github.com/hyperledger/fabric-protos-go/peer"
@@ -111,7 +112,7 @@ func createApproveChaincodeProposal(signer cryptoutil.Signer, req *ApproveChainc
 }
 
 
-func Approve(signer cryptoutil.Signer, cf *CommonFactory, req *ApproveChaincodeRequest, channelID string) (*Response, error) {
+func Approve(ctx context.Context, signer cryptoutil.Signer, cf *CommonFactory, req *ApproveChaincodeRequest, channelID string) (*Response, error) {
 	proposal, txID, err := createApproveChaincodeProposal(signer, req, channelID)
 	if err != nil {
 		return nil, err
@@ -121,9 +122,9 @@ func Approve(signer cryptoutil.Signer, cf *CommonFactory, req *ApproveChaincodeR
 		return nil, err
 	}
 
-	proposalResp, err := cf.Committer.ProcessProposal(context.Background(), signedProp)
+	proposalResp, err := cf.Committer.ProcessProposal(ctx, signedProp)
 	if err != nil {
-		return nil, errors.WithMessagef(err, "fail to process proposal")
+		return nil, sdkerrors.EndorsementsFailed([]*sdkerrors.Error{sdkerrors.ProposalFailed(cf.peerAddress(0), err)})
 	}
 
 	if proposalResp.Response == nil {
@@ -131,12 +132,14 @@ func Approve(signer cryptoutil.Signer, cf *CommonFactory, req *ApproveChaincodeR
 	}
 
 	if proposalResp.Response.Status != int32(cb.Status_SUCCESS) {
-		return nil, errors.Errorf("proposal failed with status: %d - %s", proposalResp.Response.Status, proposalResp.Response.Message)
+		return nil, sdkerrors.EndorsementsFailed([]*sdkerrors.Error{
+			sdkerrors.EndorsementFailed(cf.peerAddress(0), proposalResp.Response.Status, proposalResp.Response.Message),
+		})
 	}
 
 	response := &Response{TxID: txID, Response: proposalResp.Response}
 	// TODO: 处理发送至所有peer节点
-	err = broadcastProposalEnvelope(signer, proposal, cf, channelID, txID, req.WaitForEventTimeout, proposalResp)
+	err = broadcastProposalEnvelope(ctx, signer, proposal, cf, channelID, txID, req.WaitForEventTimeout, proposalResp)
 	if err != nil {
 		return response, err
 	}
@@ -10,6 +10,7 @@ import (
 	lb "github.com/hyperledger/fabric-protos-go/peer/lifecycle"
 	"github.com/pkg/errors"
 	"io/ioutil"
+	"strings"
 )
 
 type ChaincodeInstaller interface {
@@ -42,20 +43,9 @@ func GetChaincodeInstallerFromPkgFile(pkgFile string) ChaincodeInstaller {
 	}
 }
 
-// GetChaincodeInstallerFromSource 源码安装
-func GetChaincodeInstallerFromSource(path, label, typ string) ChaincodeInstaller {
-	// TODO
-	var packager ccplatform.PlatformRegistry
-	switch typ {
-	case "GOLANG":
-		packager = &golang.Platform{}
-	}
-	return &chaincodeFromPath{packager: packager, lang: typ, label: label,ccPath: path}
-}
-
-// GetChaincodeInstallerFromGitRepo 从git安装源码
-func GetChaincodeInstallerFromGitRepo(git string) ChaincodeInstaller {
-	return nil
+// GetChaincodeInstallerFromPath 从本地链码目录打包安装，lang 为 golang、node 或 java
+func GetChaincodeInstallerFromPath(path, label, lang string) ChaincodeInstaller {
+	return &chaincodeFromPath{lang: lang, label: label, ccPath: path}
 }
 
 func (pkg *chaincodePackageFile) GetInstalledChaincode() (*lb.InstallChaincodeArgs, error) {
@@ -72,7 +62,19 @@ type chaincodeFromPath struct {
 	ccPath string
 	label  string
 	lang  string
-	packager ccplatform.PlatformRegistry
+	// metadataPath 非go链码写入元数据的路径，源码解压或者克隆到临时目录时为请求中的路径
+	metadataPath string
+}
+
+// getPackager 链码语言对应的打包方式，go链码按模块打包依赖，其他语言打包整个目录
+func getPackager(lang string) (ccplatform.PlatformRegistry, error) {
+	switch strings.ToUpper(lang) {
+	case "GOLANG":
+		return &golang.Platform{}, nil
+	case "NODE", "JAVA":
+		return sourceTree{}, nil
+	}
+	return nil, errors.Errorf("unsupported chaincode language %q", lang)
 }
 
 // PackageMetadata holds the path and type for a chaincode package
@@ -120,11 +122,21 @@ func (cc *chaincodeFromPath) getTarPackage() ([]byte, error) {
 	gw := gzip.NewWriter(payload)
 	tw := tar.NewWriter(gw)
 
-	normalizedPath, err := cc.packager.NormalizePath(cc.ccPath)
+	if cc.label == "" {
+		return nil, errors.New("chaincode label is required")
+	}
+	packager, err := getPackager(cc.lang)
 	if err != nil {
 		return nil, err
 	}
-	metadataBytes, err := toJSON(normalizedPath, cc.lang, cc.ccPath)
+	normalizedPath, err := packager.NormalizePath(cc.ccPath)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := packager.(sourceTree); ok && cc.metadataPath != "" {
+		normalizedPath = cc.metadataPath
+	}
+	metadataBytes, err := toJSON(normalizedPath, strings.ToLower(cc.lang), cc.label)
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +144,7 @@ func (cc *chaincodeFromPath) getTarPackage() ([]byte, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "error writing package metadata to tar")
 	}
-	codeBytes, err := cc.packager.GetDeploymentPayload(cc.ccPath)
+	codeBytes, err := packager.GetDeploymentPayload(cc.ccPath)
 	if err != nil {
 		return nil, errors.WithMessage(err, "error getting chaincode bytes")
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
 	"github.com/godzilla-s/fabricsdk-go/internal/utils"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
 	"github.com/golang/protobuf/proto"
 	cb "github.com/hyperledger/fabric-protos-go/common"
 	pb "github.com/hyperledger/fabric-protos-go/peer"
@@ -60,7 +61,7 @@ func createCommitProposal(signer cryptoutil.Signer, cr *CommitChaincodeRequest,
 	return proposal, txID, nil
 }
 
-func Commit(signer cryptoutil.Signer, cf *CommonFactory, req *CommitChaincodeRequest, channelID string) (*Response, error) {
+func Commit(ctx context.Context, signer cryptoutil.Signer, cf *CommonFactory, req *CommitChaincodeRequest, channelID string) (*Response, error) {
 	proposal, txID,  err := createCommitProposal(signer, req, channelID)
 	if err != nil {
 		return nil, err
@@ -72,19 +73,25 @@ func Commit(signer cryptoutil.Signer, cf *CommonFactory, req *CommitChaincodeReq
 	}
 
 	var responses []*pb.ProposalResponse
-	for _, commit := range cf.Endorsers {
-		resp, err := commit.ProcessProposal(context.Background(), signedProp)
+	var failed []*sdkerrors.Error
+	for i, commit := range cf.Endorsers {
+		resp, err := commit.ProcessProposal(ctx, signedProp)
 		if err != nil {
-			return nil, errors.WithMessage(err, "failed to endorse proposal")
+			failed = append(failed, sdkerrors.ProposalFailed(cf.peerAddress(i), err))
+			continue
 		}
 
 		if resp.Response.Status != int32(cb.Status_SUCCESS) {
-			return nil, errors.Errorf("proposal failed with status: %d - %s", resp.Response.Status, resp.Response.Message)
+			failed = append(failed, sdkerrors.EndorsementFailed(cf.peerAddress(i), resp.Response.Status, resp.Response.Message))
+			continue
 		}
 		responses = append(responses, resp)
 	}
+	if len(failed) > 0 {
+		return nil, sdkerrors.EndorsementsFailed(failed)
+	}
 
-	err = broadcastProposalEnvelope(signer, proposal, cf, channelID, txID, req.WaitForEventTimeout, responses...)
+	err = broadcastProposalEnvelope(ctx, signer, proposal, cf, channelID, txID, req.WaitForEventTimeout, responses...)
 	if err != nil {
 		return nil, err
 	}
@@ -4,11 +4,12 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"fmt"
 	"github.com/godzilla-s/fabricsdk-go/internal/client/delivegroup"
 	"github.com/godzilla-s/fabricsdk-go/internal/client/orderer"
+	"github.com/godzilla-s/fabricsdk-go/internal/client/peer"
 	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
 	"github.com/godzilla-s/fabricsdk-go/internal/utils"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
 	"github.com/hyperledger/fabric-protos-go/common"
 	pb "github.com/hyperledger/fabric-protos-go/peer"
 	"github.com/pkg/errors"
@@ -23,6 +24,7 @@ const (
 	LSCC_CheckCommitReadinessFuncName = "CheckCommitReadiness"
 	LSCC_QueryInstalledChaincode      = "QueryInstalledChaincodes"
 	LSCC_QueryApprivedChaincode       = "QueryApprovedChaincodeDefinition"
+	LSCC_GetInstalledChaincodePackage = "GetInstalledChaincodePackage"
 )
 
 type CommonFactory struct {
@@ -32,27 +34,37 @@ type CommonFactory struct {
 	PeerAddresses []string
 	OClient    		orderer.Client
 	TLSCert       tls.Certificate
+	// PeerClients are the clients the endorsers and delivers are created from
+	PeerClients   []peer.Client
+	// Notifiers 每个背书节点的提交通知器，设置后等待交易提交时不再为每个交易创建 DeliverGroup
+	Notifiers     []CommitNotifier
 }
 
-func (cf *CommonFactory) deliver(signer cryptoutil.Signer, channelID, txID string, waitTime time.Duration) error {
-	var cancel context.CancelFunc
-	ctx, cancel := context.WithTimeout(context.Background(), waitTime)
-	defer cancel()
-	dg := delivegroup.NewDeliverGroup(cf.Delivers, cf.PeerAddresses, signer, cf.TLSCert, channelID, txID)
-	err := dg.Connect(ctx)
-	if err != nil {
-		return errors.WithMessage(err, "fail to connect deliver group")
+// peerAddress returns the address of the i-th endorser, empty if unknown
+func (cf *CommonFactory) peerAddress(i int) string {
+	if i < len(cf.PeerAddresses) {
+		return cf.PeerAddresses[i]
 	}
-	return nil
+	return ""
 }
 
+// Close closes the peer and orderer clients of the factory
+func (cf *CommonFactory) Close() {
+	for _, c := range cf.PeerClients {
+		c.Close()
+	}
+	if cf.OClient != nil {
+		cf.OClient.Close()
+	}
+}
 
 type Response struct {
 	TxID     string
 	Response *pb.Response
 }
 
-func createSignedTx(proposal *pb.Proposal, signer cryptoutil.Signer, resps ...*pb.ProposalResponse) (*common.Envelope, error) {
+// createSignedTx 由背书结果创建交易，endpoints 为每个背书结果对应的节点地址，用于报告失败的节点
+func createSignedTx(proposal *pb.Proposal, signer cryptoutil.Signer, endpoints []string, resps ...*pb.ProposalResponse) (*common.Envelope, error) {
 	if len(resps) == 0 {
 		return nil, errors.New("at least one proposal response is required")
 	}
@@ -78,11 +90,18 @@ func createSignedTx(proposal *pb.Proposal, signer cryptoutil.Signer, resps ...*p
 		return nil, errors.New("signer must be the same as the one referenced in the header")
 	}
 
+	endpoint := func(n int) string {
+		if n < len(endpoints) {
+			return endpoints[n]
+		}
+		return ""
+	}
+
 	// ensure that all actions are bitwise equal and that they are successful
 	var a1 []byte
 	for n, r := range resps {
 		if r.Response.Status < 200 || r.Response.Status >= 400 {
-			return nil, errors.Errorf("proposal response was not successful, error code %d, msg %s", r.Response.Status, r.Response.Message)
+			return nil, sdkerrors.EndorsementFailed(endpoint(n), r.Response.Status, r.Response.Message)
 		}
 
 		if n == 0 {
@@ -91,9 +110,7 @@ func createSignedTx(proposal *pb.Proposal, signer cryptoutil.Signer, resps ...*p
 		}
 
 		if !bytes.Equal(a1, r.Payload) {
-			fmt.Println(a1)
-			fmt.Println(r.Payload)
-			return nil, errors.New("ProposalResponsePayloads do not match")
+			return nil, sdkerrors.ProposalResponsesMismatch(endpoint(n))
 		}
 	}
 
@@ -149,36 +166,50 @@ func createSignedTx(proposal *pb.Proposal, signer cryptoutil.Signer, resps ...*p
 }
 
 // broadcastProposalEnvelope 将处理的交易包广播至orderer节点
-func broadcastProposalEnvelope(signer cryptoutil.Signer, proposal *pb.Proposal, cf *CommonFactory, channelID, txID string, timeout time.Duration, responses ...*pb.ProposalResponse) error {
-	env, err := createSignedTx(proposal, signer, responses...)
+func broadcastProposalEnvelope(ctx context.Context, signer cryptoutil.Signer, proposal *pb.Proposal, cf *CommonFactory, channelID, txID string, timeout time.Duration, responses ...*pb.ProposalResponse) error {
+	env, err := createSignedTx(proposal, signer, cf.PeerAddresses, responses...)
 	if err != nil {
 		return err
 	}
 
-	broadcastClient, err := cf.OClient.GetBroadcastClient()
+	broadcastClient, err := cf.OClient.GetBroadcastClient(ctx)
 	if err != nil {
 		return err
 	}
 
 	var dg *delivegroup.DeliverGroup
-	var ctx context.Context
+	var txs []*Transaction
 	if timeout > 0 {
 		var cancelFunc context.CancelFunc
-		ctx, cancelFunc = context.WithTimeout(context.Background(), timeout)
+		ctx, cancelFunc = context.WithTimeout(ctx, timeout)
 		defer cancelFunc()
-		dg = delivegroup.NewDeliverGroup(cf.Delivers, cf.PeerAddresses, signer, cf.TLSCert, channelID, txID)
-		err := dg.Connect(ctx)
-		if err != nil {
-			return errors.WithMessage(err, "fail to connect deliver group")
+		if len(cf.Notifiers) > 0 {
+			// 在发送之前注册，避免交易在注册之前提交
+			for _, notifier := range cf.Notifiers {
+				txs = append(txs, NewTransaction(txID, notifier))
+			}
+		} else {
+			dg = delivegroup.NewDeliverGroup(cf.Delivers, cf.PeerAddresses, signer, cf.TLSCert, channelID, txID)
+			err := dg.Connect(ctx)
+			if err != nil {
+				return errors.WithMessage(err, "fail to connect deliver group")
+			}
 		}
 	}
 
 	err = broadcastClient.Send(env)
 	if err != nil {
 		broadcastClient.Close()
+		cancelTransactions(txs)
 		return err
 	}
 	broadcastClient.Close()
+	if len(txs) > 0 {
+		err = waitForCommit(ctx, txs)
+		if err != nil {
+			return errors.WithMessage(err, "fail to wait for commit")
+		}
+	}
 	if dg != nil && timeout > 0 {
 		err = dg.Wait(ctx)
 		if err != nil {
@@ -0,0 +1,56 @@
+package chaincode
+
+import (
+	"context"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"testing"
+)
+
+func TestCreateSignedTxEndpoint(t *testing.T) {
+	signer := testSigner(t)
+	proposal, _, err := createInvocationProposal(signer, ChaincodeSpec{Name: "mycc"}, "mychannel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpoints := []string{"peer0", "peer1"}
+	ok := &pb.ProposalResponse{Response: &pb.Response{Status: 200}, Payload: []byte("a")}
+
+	_, err = createSignedTx(proposal, signer, endpoints, ok, &pb.ProposalResponse{Response: &pb.Response{Status: 500}})
+	if e, _ := sdkerrors.As(err); e == nil || e.Code != sdkerrors.EndorsementFailure || e.Endpoint != "peer1" {
+		t.Fatalf("expected endorsement failure of peer1, got %v", err)
+	}
+	_, err = createSignedTx(proposal, signer, endpoints, ok, &pb.ProposalResponse{Response: &pb.Response{Status: 200}, Payload: []byte("b")})
+	if e, _ := sdkerrors.As(err); e == nil || e.Code != sdkerrors.ProposalMismatch || e.Endpoint != "peer1" {
+		t.Fatalf("expected mismatch of peer1, got %v", err)
+	}
+}
+
+// errorEndorser 返回状态为500的错误响应
+type errorEndorser struct {
+	pb.EndorserClient
+	message string
+}
+
+func (e *errorEndorser) ProcessProposal(context.Context, *pb.SignedProposal, ...grpc.CallOption) (*pb.ProposalResponse, error) {
+	return &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: e.message}}, nil
+}
+
+func TestQueryChaincodeError(t *testing.T) {
+	cf := &CommonFactory{Endorsers: []pb.EndorserClient{&errorEndorser{message: "asset not found"}}, PeerAddresses: []string{"peer0"}}
+	_, err := Query(context.Background(), testSigner(t), cf, ChaincodeSpec{Name: "mycc"}, "mychannel")
+	e, _ := sdkerrors.As(err)
+	if e == nil || e.Code != sdkerrors.EndorsementFailure || e.Endpoint != "peer0" || e.Status != 500 {
+		t.Fatalf("expected endorsement failure of peer0, got %v", err)
+	}
+}
+
+func TestInitAlreadyInitialized(t *testing.T) {
+	cf := &CommonFactory{Endorsers: []pb.EndorserClient{&errorEndorser{message: "chaincode 'mycc' is already initialized but called as init"}}, PeerAddresses: []string{"peer0"}}
+	_, err := Invoke(context.Background(), testSigner(t), cf, ChaincodeSpec{Name: "mycc", IsInit: true}, "mychannel")
+	if !errors.Is(err, ErrAlreadyInitialized) || !sdkerrors.Is(err, sdkerrors.EndorsementFailure) {
+		t.Fatalf("expected an endorsement failure of an initialized chaincode, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package contract
 
 import (
+	"context"
 	"github.com/godzilla-s/fabricsdk-go/internal/chaincode"
 	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
 	"github.com/pkg/errors"
@@ -15,8 +16,8 @@ type Contract struct {
 	cf *chaincode.CommonFactory
 }
 
-func New(signer cryptoutil.Signer, name, version, channelID string, impl *chaincode.CommonFactory, options ...Option) (*Contract, error) {
-	_, err := chaincode.QueryCommitted(signer, impl.Committer, channelID, chaincode.WithName(name))
+func New(ctx context.Context, signer cryptoutil.Signer, name, version, channelID string, impl *chaincode.CommonFactory, options ...Option) (*Contract, error) {
+	_, err := chaincode.QueryCommitted(ctx, signer, impl.Committer, channelID, chaincode.WithName(name))
 	if err != nil {
 		return nil, errors.WithMessagef(err, "fail to get chaincode %s:%s that commit on channel %s", name, version, channelID)
 	}
@@ -37,7 +38,7 @@ func New(signer cryptoutil.Signer, name, version, channelID string, impl *chainc
 }
 
 // Invoke 调用合约
-func (c Contract) Invoke(args [][]byte, opts ...Option) (*chaincode.Response, error) {
+func (c Contract) Invoke(ctx context.Context, args [][]byte, opts ...Option) (*chaincode.Response, error) {
 	spec := &chaincode.ChaincodeSpec{Lang: c.lang}
 	for _, opt := range opts {
 		spec = opt(spec)
@@ -46,22 +47,35 @@ func (c Contract) Invoke(args [][]byte, opts ...Option) (*chaincode.Response, er
 	spec.Version = c.version
 	spec.Args = args
 	spec.Lang = c.lang
-	return chaincode.Invoke(c.signer, c.cf, *spec, c.channelID)
+	return chaincode.Invoke(ctx, c.signer, c.cf, *spec, c.channelID)
+}
+
+// Submit 调用合约，交易发送给排序节点后即返回，通过 notifier 等待交易提交
+func (c Contract) Submit(ctx context.Context, args [][]byte, notifier chaincode.CommitNotifier, opts ...Option) (*chaincode.Transaction, error) {
+	spec := &chaincode.ChaincodeSpec{Lang: c.lang}
+	for _, opt := range opts {
+		spec = opt(spec)
+	}
+	spec.Name = c.name
+	spec.Version = c.version
+	spec.Args = args
+	spec.Lang = c.lang
+	return chaincode.Submit(ctx, c.signer, c.cf, *spec, c.channelID, notifier)
 }
 
 // Query 查询合约
-func (c Contract) Query(args [][]byte) (*chaincode.Response, error) {
+func (c Contract) Query(ctx context.Context, args [][]byte) (*chaincode.Response, error) {
 	spec := chaincode.ChaincodeSpec{
 		Name: c.name,
 		Version: c.version,
 		Args: args,
 	}
-	return chaincode.Query(c.signer, c.cf, spec, c.channelID)
+	return chaincode.Query(ctx, c.signer, c.cf, spec, c.channelID)
 }
 
 
 // SendTransaction 只发送交易，不产生区块
-func (c Contract) SendTransaction(args [][]byte, opts ...Option) (*chaincode.ProcessProposalResult, error) {
+func (c Contract) SendTransaction(ctx context.Context, args [][]byte, opts ...Option) (*chaincode.ProcessProposalResult, error) {
 	spec := &chaincode.ChaincodeSpec{Lang: c.lang}
 	for _, opt := range opts {
 		spec = opt(spec)
@@ -70,6 +84,6 @@ func (c Contract) SendTransaction(args [][]byte, opts ...Option) (*chaincode.Pro
 	spec.Version = c.version
 	spec.Args = args
 	// TODO
-	return chaincode.SendTransaction(c.signer, c.cf, *spec, c.channelID)
+	return chaincode.SendTransaction(ctx, c.signer, c.cf, *spec, c.channelID)
 }
 
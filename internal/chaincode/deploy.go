@@ -0,0 +1,170 @@
+package chaincode
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	lb "github.com/hyperledger/fabric-protos-go/peer/lifecycle"
+	"github.com/pkg/errors"
+	"io"
+	"io/ioutil"
+)
+
+const (
+	defaultEndorsementPlugin = "escc"
+	defaultValidationPlugin  = "vscc"
+	// defaultEndorsementPolicyRef 未设置背书策略时 _lifecycle 使用的通道策略
+	defaultEndorsementPolicyRef = "/Channel/Application/Endorsement"
+)
+
+// PackageID 计算链码包的ID，与peer一致为 label:sha256(包)
+func PackageID(pkgBytes []byte) (string, error) {
+	metadata, err := packageMetadata(pkgBytes)
+	if err != nil {
+		return "", err
+	}
+	if metadata.Label == "" {
+		return "", errors.New("chaincode package has no label")
+	}
+	return fmt.Sprintf("%s:%x", metadata.Label, sha256.Sum256(pkgBytes)), nil
+}
+
+// packageMetadata 读取链码包中的metadata.json
+func packageMetadata(pkgBytes []byte) (*PackageMetadata, error) {
+	gr, err := gzip.NewReader(bytes.NewReader(pkgBytes))
+	if err != nil {
+		return nil, errors.Wrap(err, "read chaincode package")
+	}
+	defer gr.Close()
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil, errors.New("metadata.json not found in chaincode package")
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "read chaincode package")
+		}
+		if header.Name != "metadata.json" {
+			continue
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, errors.Wrap(err, "read metadata.json")
+		}
+		metadata := &PackageMetadata{}
+		if err := json.Unmarshal(data, metadata); err != nil {
+			return nil, errors.Wrap(err, "unmarshal metadata.json")
+		}
+		return metadata, nil
+	}
+}
+
+// FindCommitted 在已提交的链码定义中查找名称为 name 的定义，不存在时返回nil
+func FindCommitted(list *CommittedChaincodeList, name string) *lb.QueryChaincodeDefinitionsResult_ChaincodeDefinition {
+	for _, def := range list.ChaincodeDefinitions {
+		if def.Name == name {
+			return def
+		}
+	}
+	return nil
+}
+
+// ResolveSequence 根据通道上已提交的定义确定部署 req 使用的sequence并写入 req.Sequence。
+// 已提交的定义与 req 一致，并且 approvedPackages 返回的各组织在该sequence授信的链码包都是 packageID 时返回true
+func ResolveSequence(list *CommittedChaincodeList, req *CommitChaincodeRequest, packageID string, approvedPackages func(sequence int64) ([]string, error)) (bool, error) {
+	var committed bool
+	current := FindCommitted(list, req.Name)
+	if current != nil {
+		matches, err := DefinitionMatches(current, req)
+		if err != nil {
+			return false, err
+		}
+		if matches {
+			// 链码包不属于链码定义，须比较各组织授信的包
+			packages, err := approvedPackages(current.Sequence)
+			if err != nil {
+				return false, err
+			}
+			committed = true
+			for _, id := range packages {
+				if id != packageID {
+					committed = false
+					break
+				}
+			}
+		}
+	}
+	switch {
+	case req.Sequence != 0:
+		if current != nil && req.Sequence < current.Sequence {
+			return false, errors.Errorf("sequence %d is behind the committed sequence %d", req.Sequence, current.Sequence)
+		}
+		committed = committed && req.Sequence == current.Sequence
+	case current == nil:
+		req.Sequence = 1
+	case committed:
+		req.Sequence = current.Sequence
+	default:
+		req.Sequence = current.Sequence + 1
+	}
+	return committed, nil
+}
+
+// DefinitionMatches 已提交的定义与 req 是否一致（不比较sequence），
+// 未设置的插件和背书策略按 _lifecycle 的默认值比较
+func DefinitionMatches(def *lb.QueryChaincodeDefinitionsResult_ChaincodeDefinition, req *CommitChaincodeRequest) (bool, error) {
+	policyBytes, err := createPolicyBytes(req.SignPolicy, req.ChannelConfigPolicy)
+	if err != nil {
+		return false, err
+	}
+	if policyBytes == nil {
+		policyBytes = defaultPolicyBytes()
+	}
+	var collections *pb.CollectionConfigPackage
+	if len(req.CollectionConfig) > 0 {
+		collections, _, err = getCollectionConfigFromBytes(req.CollectionConfig)
+		if err != nil {
+			return false, err
+		}
+	}
+	endorsementPlugin, validationPlugin := req.EndorsementPlugin, req.ValidationPlugin
+	if endorsementPlugin == "" {
+		endorsementPlugin = defaultEndorsementPlugin
+	}
+	if validationPlugin == "" {
+		validationPlugin = defaultValidationPlugin
+	}
+
+	return def.Version == req.Version &&
+		def.EndorsementPlugin == endorsementPlugin &&
+		def.ValidationPlugin == validationPlugin &&
+		def.InitRequired == req.InitRequired &&
+		policyEqual(def.ValidationParameter, policyBytes) &&
+		len(def.Collections.GetConfig()) == len(collections.GetConfig()) &&
+		(len(collections.GetConfig()) == 0 || proto.Equal(def.Collections, collections)), nil
+}
+
+func defaultPolicyBytes() []byte {
+	policy := &pb.ApplicationPolicy{
+		Type: &pb.ApplicationPolicy_ChannelConfigPolicyReference{
+			ChannelConfigPolicyReference: defaultEndorsementPolicyRef,
+		},
+	}
+	policyBytes, _ := proto.Marshal(policy)
+	return policyBytes
+}
+
+// policyEqual 比较反序列化后的策略，避免序列化顺序不同导致不一致
+func policyEqual(a, b []byte) bool {
+	policyA, policyB := &pb.ApplicationPolicy{}, &pb.ApplicationPolicy{}
+	if proto.Unmarshal(a, policyA) != nil || proto.Unmarshal(b, policyB) != nil {
+		return bytes.Equal(a, b)
+	}
+	return proto.Equal(policyA, policyB)
+}
@@ -0,0 +1,93 @@
+package chaincode
+
+import (
+	"crypto/sha256"
+	"fmt"
+	lb "github.com/hyperledger/fabric-protos-go/peer/lifecycle"
+	"testing"
+)
+
+func TestPackageID(t *testing.T) {
+	pkg := writeTarGz(t, map[string]string{
+		"metadata.json": `{"path":"sacc","type":"golang","label":"sacc_1"}`,
+		"code.tar.gz":   "",
+	})
+	id, err := PackageID(pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := fmt.Sprintf("sacc_1:%x", sha256.Sum256(pkg)); id != expected {
+		t.Fatalf("expected %s, got %s", expected, id)
+	}
+
+	if _, err := PackageID(writeTarGz(t, map[string]string{"code.tar.gz": ""})); err == nil {
+		t.Fatal("expected error of missing metadata")
+	}
+}
+
+func TestDefinitionMatches(t *testing.T) {
+	committed := &lb.QueryChaincodeDefinitionsResult_ChaincodeDefinition{
+		Name:                "sacc",
+		Version:             "1.0",
+		Sequence:            1,
+		EndorsementPlugin:   "escc",
+		ValidationPlugin:    "vscc",
+		ValidationParameter: defaultPolicyBytes(),
+	}
+	req := &CommitChaincodeRequest{Name: "sacc", Version: "1.0"}
+	if ok, err := DefinitionMatches(committed, req); err != nil || !ok {
+		t.Fatalf("expected default definition to match, got %v %v", ok, err)
+	}
+
+	req.Version = "2.0"
+	if ok, _ := DefinitionMatches(committed, req); ok {
+		t.Fatal("expected mismatch of version")
+	}
+	req.Version = "1.0"
+	req.SignPolicy = "OR('Org1MSP.member')"
+	if ok, _ := DefinitionMatches(committed, req); ok {
+		t.Fatal("expected mismatch of endorsement policy")
+	}
+	req.SignPolicy = ""
+	req.InitRequired = true
+	if ok, _ := DefinitionMatches(committed, req); ok {
+		t.Fatal("expected mismatch of init required")
+	}
+}
+
+func TestResolveSequenceChangedPackage(t *testing.T) {
+	list := &CommittedChaincodeList{}
+	list.ChaincodeDefinitions = []*lb.QueryChaincodeDefinitionsResult_ChaincodeDefinition{{
+		Name:                "sacc",
+		Version:             "1.0",
+		Sequence:            1,
+		EndorsementPlugin:   "escc",
+		ValidationPlugin:    "vscc",
+		ValidationParameter: defaultPolicyBytes(),
+	}}
+	approved := func(sequence int64) ([]string, error) {
+		if sequence != 1 {
+			t.Fatalf("expected packages approved at sequence 1, got %d", sequence)
+		}
+		return []string{"sacc_1:aaa", "sacc_1:aaa"}, nil
+	}
+
+	req := &CommitChaincodeRequest{Name: "sacc", Version: "1.0"}
+	committed, err := ResolveSequence(list, req, "sacc_1:aaa", approved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !committed || req.Sequence != 1 {
+		t.Fatalf("expected same package to be committed at sequence 1, got %v %d", committed, req.Sequence)
+	}
+
+	// 名称、版本和策略相同，链码包不同
+	req = &CommitChaincodeRequest{Name: "sacc", Version: "1.0"}
+	committed, err = ResolveSequence(list, req, "sacc_1:bbb", approved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if committed || req.Sequence != 2 {
+		t.Fatalf("expected changed package to be deployed at sequence 2, got %v %d", committed, req.Sequence)
+	}
+}
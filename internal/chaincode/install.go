@@ -12,7 +12,7 @@ import (
 	lb "github.com/hyperledger/fabric-protos-go/peer/lifecycle"
 )
 
-func Install(signer cryptoutil.Signer, committers []peer.Client, chaincode ChaincodeInstaller) (*protoutil.ChaincodeInstallResponse, error) {
+func Install(ctx context.Context, signer cryptoutil.Signer, committers []peer.Client, chaincode ChaincodeInstaller) (*protoutil.ChaincodeInstallResponse, error) {
 	installChaincodeArgs, err := chaincode.GetInstalledChaincode()
 	if err != nil {
 		return nil, err
@@ -48,12 +48,12 @@ func Install(signer cryptoutil.Signer, committers []peer.Client, chaincode Chain
 	var resp protoutil.ChaincodeInstallResponse
 	var successCount int
 	for _, committer := range committers {
-		endorseCli, err := committer.GetEndorser()
+		endorseCli, err := committer.GetEndorser(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		rsp, err := endorseCli.ProcessProposal(context.Background(), signedProp)
+		rsp, err := endorseCli.ProcessProposal(ctx, signedProp)
 		if err != nil {
 			return nil, err
 		}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
 	"github.com/godzilla-s/fabricsdk-go/internal/utils"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
 	"github.com/hyperledger/fabric-chaincode-go/shim"
 	cb "github.com/hyperledger/fabric-protos-go/common"
 	pb "github.com/hyperledger/fabric-protos-go/peer"
@@ -14,6 +15,9 @@ import (
 	"time"
 )
 
+// ErrAlreadyInitialized 以 IsInit 调用已初始化的链码
+var ErrAlreadyInitialized = errors.New("chaincode is already initialized")
+
 // ChaincodeSpec
 type ChaincodeSpec struct {
 	Name            string
@@ -54,7 +58,7 @@ func createInvocationProposal(signer cryptoutil.Signer, spec ChaincodeSpec, chan
 	return utils.CreateChaincodeProposalWithTxIDAndTransient(cb.HeaderType_ENDORSER_TRANSACTION, channelID, invocation, creator, "", tMap)
 }
 
-func invokeOrQeury(signer cryptoutil.Signer, cf *CommonFactory, spec ChaincodeSpec, channelID string, invoke bool) (*Response, error) {
+func invokeOrQeury(ctx context.Context, signer cryptoutil.Signer, cf *CommonFactory, spec ChaincodeSpec, channelID string, invoke bool) (*Response, error) {
 	propsal, txID, err := createInvocationProposal(signer, spec, channelID)
 	if err != nil {
 		return nil, err
@@ -65,7 +69,7 @@ func invokeOrQeury(signer cryptoutil.Signer, cf *CommonFactory, spec ChaincodeSp
 		return nil, err
 	}
 
-	proposalResps, err := processProposal(signeProp, cf)
+	proposalResps, err := processProposal(ctx, signeProp, cf)
 	if err != nil {
 		return nil, err
 	}
@@ -77,11 +81,16 @@ func invokeOrQeury(signer cryptoutil.Signer, cf *CommonFactory, spec ChaincodeSp
 	proposalResp := proposalResps[0]
 	response := &Response{TxID: txID, Response: proposalResp.Response}
 	if proposalResp.Response.Status >= shim.ERRORTHRESHOLD {
-		return response, nil
+		e := sdkerrors.EndorsementFailed(cf.peerAddress(0), proposalResp.Response.Status, proposalResp.Response.Message)
+		// 节点只在消息中说明链码已初始化
+		if spec.IsInit && strings.Contains(proposalResp.Response.Message, "already initialized") {
+			e.WithCause(ErrAlreadyInitialized)
+		}
+		return nil, e
 	}
 
 	if invoke {
-		err = broadcastProposalEnvelope(signer, propsal, cf, channelID, txID, spec.Timeout, proposalResps...)
+		err = broadcastProposalEnvelope(ctx, signer, propsal, cf, channelID, txID, spec.Timeout, proposalResps...)
 		if err != nil {
 			return response, err
 		}
@@ -89,48 +98,47 @@ func invokeOrQeury(signer cryptoutil.Signer, cf *CommonFactory, spec ChaincodeSp
 	return response, nil
 }
 
-func processProposal(signedProp *pb.SignedProposal, cf *CommonFactory) ([]*pb.ProposalResponse, error) {
-	responsesCh := make(chan *pb.ProposalResponse, len(cf.Endorsers))
-	errorCh := make(chan error, len(cf.Endorsers))
+func processProposal(ctx context.Context, signedProp *pb.SignedProposal, cf *CommonFactory) ([]*pb.ProposalResponse, error) {
+	responses := make([]*pb.ProposalResponse, len(cf.Endorsers))
+	errs := make([]*sdkerrors.Error, len(cf.Endorsers))
 
 	wg := sync.WaitGroup{}
-	for _, endorser := range cf.Endorsers {
+	for i, endorser := range cf.Endorsers {
 		wg.Add(1)
-		go func(endorser pb.EndorserClient) {
+		go func(i int, endorser pb.EndorserClient) {
 			defer wg.Done()
-			proposalResp, err := endorser.ProcessProposal(context.Background(), signedProp)
+			proposalResp, err := endorser.ProcessProposal(ctx, signedProp)
 			if err != nil {
-				errorCh <- err
+				errs[i] = sdkerrors.ProposalFailed(cf.peerAddress(i), err)
 				return
 			}
-			responsesCh <- proposalResp
-		}(endorser)
+			responses[i] = proposalResp
+		}(i, endorser)
 	}
 	wg.Wait()
-	close(responsesCh)
-	close(errorCh)
 
-	for err := range errorCh {
-		return nil, err
+	var failed []*sdkerrors.Error
+	for _, err := range errs {
+		if err != nil {
+			failed = append(failed, err)
+		}
 	}
-
-	var responses []*pb.ProposalResponse
-	for response := range responsesCh {
-		responses = append(responses, response)
+	if len(failed) > 0 {
+		return nil, sdkerrors.EndorsementsFailed(failed)
 	}
 	return responses, nil
 }
 
-func Invoke(signer cryptoutil.Signer, cf *CommonFactory, spec ChaincodeSpec, channelID string) (*Response, error) {
-	resp, err := invokeOrQeury(signer, cf, spec, channelID, true)
+func Invoke(ctx context.Context, signer cryptoutil.Signer, cf *CommonFactory, spec ChaincodeSpec, channelID string) (*Response, error) {
+	resp, err := invokeOrQeury(ctx, signer, cf, spec, channelID, true)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
-func Query(signer cryptoutil.Signer, cf *CommonFactory, spec ChaincodeSpec, channelID string) (*Response, error) {
-	return invokeOrQeury(signer, cf, spec, channelID, false)
+func Query(ctx context.Context, signer cryptoutil.Signer, cf *CommonFactory, spec ChaincodeSpec, channelID string) (*Response, error) {
+	return invokeOrQeury(ctx, signer, cf, spec, channelID, false)
 }
 
 type ProcessProposalResult struct {
@@ -139,7 +147,7 @@ type ProcessProposalResult struct {
 	Responses []*pb.ProposalResponse
 }
 
-func SendTransaction(signer cryptoutil.Signer, cf *CommonFactory, spec ChaincodeSpec, channelID string) (*ProcessProposalResult, error) {
+func SendTransaction(ctx context.Context, signer cryptoutil.Signer, cf *CommonFactory, spec ChaincodeSpec, channelID string) (*ProcessProposalResult, error) {
 	proposal, txID, err := createInvocationProposal(signer, spec, channelID)
 	if err != nil {
 		return nil, err
@@ -150,7 +158,7 @@ func SendTransaction(signer cryptoutil.Signer, cf *CommonFactory, spec Chaincode
 		return nil, err
 	}
 
-	proposalResps, err := processProposal(signeProp, cf)
+	proposalResps, err := processProposal(ctx, signeProp, cf)
 	if err != nil {
 		return nil, err
 	}
@@ -9,8 +9,25 @@ import (
 	pb "github.com/hyperledger/fabric-protos-go/peer"
 	lb "github.com/hyperledger/fabric-protos-go/peer/lifecycle"
 	"github.com/pkg/errors"
+	"strings"
 )
 
+var (
+	// ErrNotDefined 链码尚未在通道上提交定义
+	ErrNotDefined = errors.New("chaincode is not defined")
+	// ErrNotApproved 组织没有授信查询的链码定义
+	ErrNotApproved = errors.New("chaincode definition is not approved")
+)
+
+// queryFailed 返回查询失败的错误，_lifecycle 只在消息中说明查询的对象不存在，
+// 消息包含 notFound 时返回的错误包装 sentinel
+func queryFailed(resp *pb.Response, notFound string, sentinel error) error {
+	if strings.Contains(resp.Message, notFound) {
+		return errors.Wrapf(sentinel, "query failed with status: %d - %s", resp.Status, resp.Message)
+	}
+	return errors.Errorf("query failed with status: %d - %s", resp.Status, resp.Message)
+}
+
 func createQueryInstalledProposal(signer cryptoutil.Signer) (*pb.Proposal, error) {
 	args := &lb.QueryInstalledChaincodesArgs{}
 	argsBytes, err := proto.Marshal(args)
@@ -45,7 +62,7 @@ type InstalledChaincodeList struct {
 	lb.QueryInstalledChaincodesResult
 }
 
-func QueryInstalled(signer cryptoutil.Signer, endorseCli pb.EndorserClient) (*InstalledChaincodeList, error) {
+func QueryInstalled(ctx context.Context, signer cryptoutil.Signer, endorseCli pb.EndorserClient) (*InstalledChaincodeList, error) {
 	proposal, err := createQueryInstalledProposal(signer)
 	if err != nil {
 		return nil, err
@@ -55,7 +72,7 @@ func QueryInstalled(signer cryptoutil.Signer, endorseCli pb.EndorserClient) (*In
 		return nil, errors.WithMessage(err, "failed to create signed proposal")
 	}
 
-	resp, err := endorseCli.ProcessProposal(context.Background(), signedProp)
+	resp, err := endorseCli.ProcessProposal(ctx, signedProp)
 	if err != nil {
 		return nil, errors.WithMessage(err, "failed to endorse proposal")
 	}
@@ -79,10 +96,67 @@ func QueryInstalled(signer cryptoutil.Signer, endorseCli pb.EndorserClient) (*In
 	return response, nil
 }
 
-func createQueryApprovedProposal(signer cryptoutil.Signer, name, channelID string) (*pb.Proposal, error) {
+func createGetInstalledPackageProposal(signer cryptoutil.Signer, packageID string) (*pb.Proposal, error) {
+	args := &lb.GetInstalledChaincodePackageArgs{PackageId: packageID}
+	argsBytes, err := proto.Marshal(args)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal args")
+	}
+	ccInput := &pb.ChaincodeInput{Args: [][]byte{[]byte(LSCC_GetInstalledChaincodePackage), argsBytes}}
+
+	cis := &pb.ChaincodeInvocationSpec{
+		ChaincodeSpec: &pb.ChaincodeSpec{
+			ChaincodeId: &pb.ChaincodeID{Name: LifeCycleName},
+			Input:       ccInput,
+		},
+	}
+
+	creator, err := signer.Serialize()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to serialize identity")
+	}
+	proposal, _, err := utils.CreateProposalFromCIS(cb.HeaderType_ENDORSER_TRANSACTION, "", cis, creator)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to create ChaincodeInvocationSpec proposal")
+	}
+	return proposal, nil
+}
+
+// GetInstalledPackage 从节点下载已安装的链码包
+func GetInstalledPackage(ctx context.Context, signer cryptoutil.Signer, endorseCli pb.EndorserClient, packageID string) ([]byte, error) {
+	proposal, err := createGetInstalledPackageProposal(signer, packageID)
+	if err != nil {
+		return nil, err
+	}
+	signedProp, err := cryptoutil.GetSignedProposal(proposal, signer)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to create signed proposal")
+	}
+
+	resp, err := endorseCli.ProcessProposal(ctx, signedProp)
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to endorse proposal")
+	}
+	if resp.Response == nil {
+		return nil, errors.New("received proposal response with nil response")
+	}
+
+	if resp.Response.Status != int32(cb.Status_SUCCESS) {
+		return nil, errors.Errorf("query failed with status: %d - %s", resp.Response.Status, resp.Response.Message)
+	}
+
+	result := &lb.GetInstalledChaincodePackageResult{}
+	err = proto.Unmarshal(resp.Response.Payload, result)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal proposal response's response payload")
+	}
+	return result.ChaincodeInstallPackage, nil
+}
+
+func createQueryApprovedProposal(signer cryptoutil.Signer, name string, sequence int64, channelID string) (*pb.Proposal, error) {
 	args := &lb.QueryApprovedChaincodeDefinitionArgs{
 		Name:     name,
-		Sequence: 0,
+		Sequence: sequence,
 	}
 	argsBytes, err := proto.Marshal(args)
 	if err != nil {
@@ -112,8 +186,13 @@ type ApprovedChaincodeList struct {
 	*lb.QueryApprovedChaincodeDefinitionResult
 }
 
-func QueryApproved(signer cryptoutil.Signer, endorser pb.EndorserClient, name, channelID string) (*ApprovedChaincodeList, error) {
-	proposal, err := createQueryApprovedProposal(signer, name, channelID)
+// QueryApproved 查询组织授信的链码定义，sequence 为0（默认）时查询最新授信的定义，可以使用 WithSequence 指定
+func QueryApproved(ctx context.Context, signer cryptoutil.Signer, endorser pb.EndorserClient, name, channelID string, opts ...Option) (*ApprovedChaincodeList, error) {
+	req := &QueryApprovedChaincodeRequest{}
+	for _, opt := range opts {
+		req = opt(req).(*QueryApprovedChaincodeRequest)
+	}
+	proposal, err := createQueryApprovedProposal(signer, name, req.Sequence, channelID)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +200,7 @@ func QueryApproved(signer cryptoutil.Signer, endorser pb.EndorserClient, name, c
 	if err != nil {
 		return nil, err
 	}
-	proposalResp, err := endorser.ProcessProposal(context.Background(), signedProp)
+	proposalResp, err := endorser.ProcessProposal(ctx, signedProp)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +209,7 @@ func QueryApproved(signer cryptoutil.Signer, endorser pb.EndorserClient, name, c
 	}
 
 	if proposalResp.Response.Status != int32(cb.Status_SUCCESS) {
-		return nil, errors.Errorf("query failed with status: %d - %s", proposalResp.Response.Status, proposalResp.Response.Message)
+		return nil, queryFailed(proposalResp.Response, "could not fetch approved chaincode definition", ErrNotApproved)
 	}
 
 	result := &lb.QueryApprovedChaincodeDefinitionResult{}
@@ -183,7 +262,7 @@ type CommittedChaincodeList struct {
 	lb.QueryChaincodeDefinitionResult
 }
 
-func QueryCommitted(signer cryptoutil.Signer, endorseCli pb.EndorserClient, channelID string, opts ...Option) (*CommittedChaincodeList, error) {
+func QueryCommitted(ctx context.Context, signer cryptoutil.Signer, endorseCli pb.EndorserClient, channelID string, opts ...Option) (*CommittedChaincodeList, error) {
 	req := &QueryCommittedChaincodeRequest{}
 	for _, opt := range opts {
 		req = opt(req).(*QueryCommittedChaincodeRequest)
@@ -199,7 +278,7 @@ func QueryCommitted(signer cryptoutil.Signer, endorseCli pb.EndorserClient, chan
 		return nil, errors.WithMessage(err, "failed to create signed proposal")
 	}
 
-	resp, err := endorseCli.ProcessProposal(context.Background(), signedProposal)
+	resp, err := endorseCli.ProcessProposal(ctx, signedProposal)
 	if err != nil {
 		return nil, err
 	}
@@ -208,7 +287,7 @@ func QueryCommitted(signer cryptoutil.Signer, endorseCli pb.EndorserClient, chan
 	}
 
 	if resp.Response.Status != int32(cb.Status_SUCCESS) {
-		return nil, errors.Errorf("query failed with status: %d - %s", resp.Response.Status, resp.Response.Message)
+		return nil, queryFailed(resp.Response, "is not defined", ErrNotDefined)
 	}
 
 	var list CommittedChaincodeList
@@ -219,22 +298,46 @@ func QueryCommitted(signer cryptoutil.Signer, endorseCli pb.EndorserClient, chan
 			return nil, errors.Wrap(err, "failed to unmarshal proposal response's response payload")
 		}
 		list.ChaincodeDefinitions = result.ChaincodeDefinitions
+		return &list, nil
 	}
 	result := &lb.QueryChaincodeDefinitionResult{}
 	err = proto.Unmarshal(resp.Response.Payload, result)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to unmarshal proposal response's response payload")
 	}
+	list.Sequence = result.Sequence
+	list.Version = result.Version
+	list.EndorsementPlugin = result.EndorsementPlugin
+	list.ValidationPlugin = result.ValidationPlugin
+	list.ValidationParameter = result.ValidationParameter
+	list.Collections = result.Collections
+	list.InitRequired = result.InitRequired
+	list.Approvals = result.Approvals
 	return &list, nil
 }
 
 func createCommitReadinessProposal(signer cryptoutil.Signer, cr *CheckCommitReadinessRequest, channelID string) (*pb.Proposal, error) {
+	// 背书策略和私有数据集合须与授信时一致，否则授信结果不匹配
+	policyBytes, err := createPolicyBytes(cr.SignPolicy, cr.ChannelConfigPolicy)
+	if err != nil {
+		return nil, err
+	}
+	var ccpkg *pb.CollectionConfigPackage
+	if len(cr.CollectionConfig) > 0 {
+		ccpkg, _, err = getCollectionConfigFromBytes(cr.CollectionConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
 	args := &lb.CheckCommitReadinessArgs{
-		Name:              cr.Name,
-		Version:           cr.Version,
-		Sequence:          cr.Sequence,
-		InitRequired:      cr.InitRequired,
-		EndorsementPlugin: cr.EndorsementPlugin,
+		Name:                cr.Name,
+		Version:             cr.Version,
+		Sequence:            cr.Sequence,
+		InitRequired:        cr.InitRequired,
+		EndorsementPlugin:   cr.EndorsementPlugin,
+		ValidationPlugin:    cr.ValidationPlugin,
+		ValidationParameter: policyBytes,
+		Collections:         ccpkg,
 	}
 	argsBytes, err := proto.Marshal(args)
 	if err != nil {
@@ -266,7 +369,7 @@ type CheckCommitReadinessResult struct {
 	lb.CheckCommitReadinessResult
 }
 
-func CheckCommitReadiness(signer cryptoutil.Signer, endorseCli pb.EndorserClient, channelID string, opts ...Option) (*CheckCommitReadinessResult, error) {
+func CheckCommitReadiness(ctx context.Context, signer cryptoutil.Signer, endorseCli pb.EndorserClient, channelID string, opts ...Option) (*CheckCommitReadinessResult, error) {
 	req := &CheckCommitReadinessRequest{}
 	for _, opt := range opts {
 		req = opt(req).(*CheckCommitReadinessRequest)
@@ -284,7 +387,7 @@ func CheckCommitReadiness(signer cryptoutil.Signer, endorseCli pb.EndorserClient
 		return nil, errors.WithMessage(err, "failed to create signed proposal")
 	}
 
-	proposalResponse, err := endorseCli.ProcessProposal(context.Background(), signeProp)
+	proposalResponse, err := endorseCli.ProcessProposal(ctx, signeProp)
 	if err != nil {
 		return nil, errors.WithMessage(err, "failed to endorse proposal")
 	}
@@ -0,0 +1,133 @@
+package chaincode
+
+import (
+	"context"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/golang/protobuf/proto"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	lb "github.com/hyperledger/fabric-protos-go/peer/lifecycle"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"testing"
+)
+
+// lifecycleEndorser 记录 _lifecycle 的调用参数，并返回固定的结果
+type lifecycleEndorser struct {
+	t       *testing.T
+	args    [][]byte
+	payload proto.Message
+}
+
+func (e *lifecycleEndorser) ProcessProposal(ctx context.Context, in *pb.SignedProposal, opts ...grpc.CallOption) (*pb.ProposalResponse, error) {
+	prop := &pb.Proposal{}
+	if err := proto.Unmarshal(in.ProposalBytes, prop); err != nil {
+		e.t.Fatal(err)
+	}
+	payload := &pb.ChaincodeProposalPayload{}
+	if err := proto.Unmarshal(prop.Payload, payload); err != nil {
+		e.t.Fatal(err)
+	}
+	cis := &pb.ChaincodeInvocationSpec{}
+	if err := proto.Unmarshal(payload.Input, cis); err != nil {
+		e.t.Fatal(err)
+	}
+	e.args = cis.ChaincodeSpec.Input.Args
+	payloadBytes, err := proto.Marshal(e.payload)
+	if err != nil {
+		e.t.Fatal(err)
+	}
+	return &pb.ProposalResponse{Response: &pb.Response{Status: 200, Payload: payloadBytes}}, nil
+}
+
+func testSigner(t *testing.T) cryptoutil.Signer {
+	id, err := cryptoutil.LoadMSPDir("../../example/testdata/crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/msp", "Org1MSP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := id.NewSigner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+func TestQueryApprovedSequence(t *testing.T) {
+	endorser := &lifecycleEndorser{t: t, payload: &lb.QueryApprovedChaincodeDefinitionResult{Sequence: 2, Version: "2.0"}}
+	result, err := QueryApproved(context.Background(), testSigner(t), endorser, "sacc", "mychannel", WithSequence(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Sequence != 2 || result.Version != "2.0" {
+		t.Fatalf("unexpected result %v", result)
+	}
+	args := &lb.QueryApprovedChaincodeDefinitionArgs{}
+	if err := proto.Unmarshal(endorser.args[1], args); err != nil {
+		t.Fatal(err)
+	}
+	if string(endorser.args[0]) != LSCC_QueryApprivedChaincode || args.Name != "sacc" || args.Sequence != 2 {
+		t.Fatalf("unexpected args %s %v", endorser.args[0], args)
+	}
+}
+
+func TestQueryCommitted(t *testing.T) {
+	signer := testSigner(t)
+	endorser := &lifecycleEndorser{t: t, payload: &lb.QueryChaincodeDefinitionResult{
+		Sequence:  3,
+		Version:   "1.1",
+		Approvals: map[string]bool{"Org1MSP": true},
+	}}
+	list, err := QueryCommitted(context.Background(), signer, endorser, "mychannel", WithName("sacc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Sequence != 3 || list.Version != "1.1" || !list.Approvals["Org1MSP"] {
+		t.Fatalf("unexpected definition %v", list.QueryChaincodeDefinitionResult.String())
+	}
+
+	endorser.payload = &lb.QueryChaincodeDefinitionsResult{
+		ChaincodeDefinitions: []*lb.QueryChaincodeDefinitionsResult_ChaincodeDefinition{{Name: "sacc", Sequence: 3}},
+	}
+	list, err = QueryCommitted(context.Background(), signer, endorser, "mychannel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(endorser.args[0]) != "QueryChaincodeDefinitions" || FindCommitted(list, "sacc") == nil {
+		t.Fatalf("unexpected definitions %v", list.ChaincodeDefinitions)
+	}
+}
+
+func TestGetInstalledPackage(t *testing.T) {
+	endorser := &lifecycleEndorser{t: t, payload: &lb.GetInstalledChaincodePackageResult{ChaincodeInstallPackage: []byte("package")}}
+	pkg, err := GetInstalledPackage(context.Background(), testSigner(t), endorser, "sacc_1:abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := &lb.GetInstalledChaincodePackageArgs{}
+	if err := proto.Unmarshal(endorser.args[1], args); err != nil {
+		t.Fatal(err)
+	}
+	if string(pkg) != "package" || args.PackageId != "sacc_1:abc" {
+		t.Fatalf("unexpected package %q for %v", pkg, args)
+	}
+}
+
+func TestQueryCommittedNotDefined(t *testing.T) {
+	endorser := &errorEndorser{message: "namespace sacc is not defined"}
+	_, err := QueryCommitted(context.Background(), testSigner(t), endorser, "mychannel", WithName("sacc"))
+	if !errors.Is(err, ErrNotDefined) {
+		t.Fatalf("expected ErrNotDefined, got %v", err)
+	}
+	endorser.message = "access denied"
+	_, err = QueryCommitted(context.Background(), testSigner(t), endorser, "mychannel", WithName("sacc"))
+	if err == nil || errors.Is(err, ErrNotDefined) {
+		t.Fatalf("expected a query failure, got %v", err)
+	}
+}
+
+func TestQueryApprovedNotApproved(t *testing.T) {
+	endorser := &errorEndorser{message: "could not fetch approved chaincode definition (name: 'sacc', sequence: '2') on channel 'mychannel'"}
+	_, err := QueryApproved(context.Background(), testSigner(t), endorser, "sacc", "mychannel", WithSequence(2))
+	if !errors.Is(err, ErrNotApproved) {
+		t.Fatalf("expected ErrNotApproved, got %v", err)
+	}
+}
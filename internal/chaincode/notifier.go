@@ -0,0 +1,306 @@
+package chaincode
+
+import (
+	"context"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/event"
+	"github.com/pkg/errors"
+	"sync"
+	"time"
+)
+
+const (
+	// recentTxs 通知器缓存的最近提交的交易数
+	recentTxs = 4096
+	// defaultNotifierIdleTimeout 通知器池中的通知器默认的空闲时间
+	defaultNotifierIdleTimeout = 5 * time.Minute
+)
+
+// DeliverCommitNotifier 通过一个 DeliverFiltered 连接接收通道上的区块，通知所有等待的交易。
+// 连接断开时自动重连并在节点之间切换。最近提交的交易会被缓存，区块到达之后才注册的等待者也能得到结果
+type DeliverCommitNotifier struct {
+	mutex   sync.Mutex
+	waiters map[string][]*commitWaiter
+	// recent 和 recentOrder 是最近提交的交易，recentOrder 按提交顺序循环使用
+	recent      map[string]*CommitStatus
+	recentOrder []string
+	recentNext  int
+	lastUsed    time.Time
+	err         error
+	cancel      context.CancelFunc
+	stopped     chan struct{}
+}
+
+type commitWaiter struct {
+	done func(*CommitStatus, error)
+}
+
+// NewDeliverCommitNotifier 从 start 开始监听通道上的区块，start 之前提交的交易不会通知，用完须调用 Close
+func NewDeliverCommitNotifier(signer cryptoutil.Signer, peers []event.DeliverPeer, channelID string, start event.Start) (*DeliverCommitNotifier, error) {
+	listener, err := event.NewBlockListener(signer, peers, event.ListenerConfig{
+		ChannelID: channelID,
+		Type:      event.FilteredBlock,
+		Start:     start,
+		// 通知器从通道当前的高度开始，重启后不需要继续
+		Checkpointer: event.NewMemoryCheckpointer(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	n := &DeliverCommitNotifier{
+		waiters:     make(map[string][]*commitWaiter),
+		recent:      make(map[string]*CommitStatus),
+		recentOrder: make([]string, recentTxs),
+		lastUsed:    time.Now(),
+		cancel:      cancel,
+		stopped:     make(chan struct{}),
+	}
+	go n.run(ctx, listener)
+	return n, nil
+}
+
+func (n *DeliverCommitNotifier) Register(txID string, done func(*CommitStatus, error)) func() {
+	w := &commitWaiter{done: done}
+	n.mutex.Lock()
+	n.lastUsed = time.Now()
+	if status, ok := n.recent[txID]; ok {
+		n.mutex.Unlock()
+		done(status, nil)
+		return func() {}
+	}
+	if n.err != nil {
+		err := n.err
+		n.mutex.Unlock()
+		done(nil, errors.WithMessagef(err, "wait for txid %s", txID))
+		return func() {}
+	}
+	n.waiters[txID] = append(n.waiters[txID], w)
+	n.mutex.Unlock()
+
+	return func() {
+		n.mutex.Lock()
+		defer n.mutex.Unlock()
+		waiters := n.waiters[txID]
+		for i := range waiters {
+			if waiters[i] == w {
+				waiters = append(waiters[:i], waiters[i+1:]...)
+				break
+			}
+		}
+		if len(waiters) == 0 {
+			delete(n.waiters, txID)
+		} else {
+			n.waiters[txID] = waiters
+		}
+	}
+}
+
+// Err 通知器停止后返回停止的原因，运行中返回nil
+func (n *DeliverCommitNotifier) Err() error {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return n.err
+}
+
+// Close 停止监听，未提交的交易返回错误
+func (n *DeliverCommitNotifier) Close() error {
+	n.cancel()
+	<-n.stopped
+	return nil
+}
+
+func (n *DeliverCommitNotifier) touch() {
+	n.mutex.Lock()
+	n.lastUsed = time.Now()
+	n.mutex.Unlock()
+}
+
+// idle 没有等待者并且超过 timeout 没有注册
+func (n *DeliverCommitNotifier) idle(now time.Time, timeout time.Duration) bool {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	return len(n.waiters) == 0 && now.Sub(n.lastUsed) >= timeout
+}
+
+func (n *DeliverCommitNotifier) run(ctx context.Context, listener *event.BlockListener) {
+	defer close(n.stopped)
+	err := listener.Run(ctx, n.notify)
+	if ctx.Err() != nil {
+		err = errors.New("commit notifier is closed")
+	}
+
+	n.mutex.Lock()
+	n.err = err
+	waiters := n.waiters
+	n.waiters = nil
+	n.mutex.Unlock()
+	for txID, ws := range waiters {
+		for _, w := range ws {
+			w.done(nil, errors.WithMessagef(err, "wait for txid %s", txID))
+		}
+	}
+}
+
+func (n *DeliverCommitNotifier) notify(block *event.Block) error {
+	for _, tx := range block.FilteredBlock.GetFilteredTransactions() {
+		status := &CommitStatus{
+			TxID:           tx.Txid,
+			ValidationCode: tx.TxValidationCode,
+			BlockNumber:    block.Number,
+			Peer:           block.Peer,
+		}
+		n.mutex.Lock()
+		waiters := n.waiters[tx.Txid]
+		delete(n.waiters, tx.Txid)
+		n.remember(status)
+		n.mutex.Unlock()
+		for _, w := range waiters {
+			w.done(status, nil)
+		}
+	}
+	return nil
+}
+
+// remember 缓存提交的交易，替换最早的一个，须持有锁
+func (n *DeliverCommitNotifier) remember(status *CommitStatus) {
+	if _, ok := n.recent[status.TxID]; ok {
+		return
+	}
+	if oldest := n.recentOrder[n.recentNext]; oldest != "" {
+		delete(n.recent, oldest)
+	}
+	n.recentOrder[n.recentNext] = status.TxID
+	n.recentNext = (n.recentNext + 1) % len(n.recentOrder)
+	n.recent[status.TxID] = status
+}
+
+// CommitNotifierPool 按 key（一般是节点、通道和签名者的组织）共享长期运行的 DeliverCommitNotifier，
+// 所有交易的等待复用同一个连接。空闲超过 idleTimeout 或者已经停止的通知器会被关闭
+type CommitNotifierPool struct {
+	mutex       sync.Mutex
+	entries     map[string]*pooledNotifier
+	idleTimeout time.Duration
+	stop        chan struct{}
+}
+
+type pooledNotifier struct {
+	notifier *DeliverCommitNotifier
+	release  func()
+}
+
+func (e *pooledNotifier) close() {
+	e.notifier.Close()
+	if e.release != nil {
+		e.release()
+	}
+}
+
+// NewCommitNotifierPool 创建空的通知器池，idleTimeout 不大于0时为5分钟
+func NewCommitNotifierPool(idleTimeout time.Duration) *CommitNotifierPool {
+	if idleTimeout <= 0 {
+		idleTimeout = defaultNotifierIdleTimeout
+	}
+	return &CommitNotifierPool{entries: make(map[string]*pooledNotifier), idleTimeout: idleTimeout}
+}
+
+// Get 返回 key 对应的通知器，不存在或者已经停止时调用 create 新建，create 返回的 release 在通知器关闭后调用
+func (p *CommitNotifierPool) Get(key string, create func() (*DeliverCommitNotifier, func(), error)) (CommitNotifier, error) {
+	p.mutex.Lock()
+	if e, ok := p.entries[key]; ok {
+		if e.notifier.Err() == nil {
+			e.notifier.touch()
+			p.mutex.Unlock()
+			return e.notifier, nil
+		}
+		delete(p.entries, key)
+		defer e.close()
+	}
+	p.mutex.Unlock()
+
+	// 创建时不持有锁，连接节点可能较慢
+	n, release, err := create()
+	if err != nil {
+		return nil, err
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if e, ok := p.entries[key]; ok && e.notifier.Err() == nil {
+		// 其他调用者同时创建了通知器
+		(&pooledNotifier{notifier: n, release: release}).close()
+		return e.notifier, nil
+	}
+	p.entries[key] = &pooledNotifier{notifier: n, release: release}
+	if p.stop == nil {
+		p.stop = make(chan struct{})
+		go p.evictIdle(p.stop)
+	}
+	return n, nil
+}
+
+// Len 返回池中通知器的数量
+func (p *CommitNotifierPool) Len() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return len(p.entries)
+}
+
+// Close 关闭池中所有的通知器，之后仍然可以使用
+func (p *CommitNotifierPool) Close() {
+	p.mutex.Lock()
+	entries := p.entries
+	p.entries = make(map[string]*pooledNotifier)
+	if p.stop != nil {
+		close(p.stop)
+		p.stop = nil
+	}
+	p.mutex.Unlock()
+	for _, e := range entries {
+		e.close()
+	}
+}
+
+func (p *CommitNotifierPool) evictIdle(stop chan struct{}) {
+	ticker := time.NewTicker(p.idleTimeout / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			var evicted []*pooledNotifier
+			p.mutex.Lock()
+			for key, e := range p.entries {
+				if e.notifier.Err() != nil || e.notifier.idle(now, p.idleTimeout) {
+					delete(p.entries, key)
+					evicted = append(evicted, e)
+				}
+			}
+			p.mutex.Unlock()
+			for _, e := range evicted {
+				e.close()
+			}
+		}
+	}
+}
+
+// waitForCommit 等待交易在每个节点上提交，交易在任一节点上无效时返回 sdkerrors.TxInvalidated
+func waitForCommit(ctx context.Context, txs []*Transaction) error {
+	defer cancelTransactions(txs)
+	for _, tx := range txs {
+		status, err := tx.Status(ctx)
+		if err != nil {
+			return err
+		}
+		if err := status.Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func cancelTransactions(txs []*Transaction) {
+	for _, tx := range txs {
+		tx.Cancel()
+	}
+}
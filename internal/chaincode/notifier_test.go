@@ -0,0 +1,171 @@
+package chaincode
+
+import (
+	"context"
+	"github.com/godzilla-s/fabricsdk-go/internal/client/orderer"
+	"github.com/godzilla-s/fabricsdk-go/internal/event"
+	"github.com/godzilla-s/fabricsdk-go/internal/utils"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"google.golang.org/grpc"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCommitNotifierRecentTxs(t *testing.T) {
+	peer := &filteredPeer{blocks: make(chan *pb.FilteredBlock, 1)}
+	notifier, err := NewDeliverCommitNotifier(testSigner(t), []event.DeliverPeer{peer}, "mychannel", event.Start{Type: event.StartBlock, BlockNumber: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer notifier.Close()
+
+	// tx1 在区块到达后才注册
+	tx0 := NewTransaction("tx0", notifier)
+	peer.blocks <- &pb.FilteredBlock{Number: 1, FilteredTransactions: []*pb.FilteredTransaction{
+		{Txid: "tx0", TxValidationCode: pb.TxValidationCode_VALID},
+		{Txid: "tx1", TxValidationCode: pb.TxValidationCode_ENDORSEMENT_POLICY_FAILURE},
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := tx0.Status(ctx); err != nil {
+		t.Fatal(err)
+	}
+	tx1 := NewTransaction("tx1", notifier)
+	select {
+	case <-tx1.Done():
+	default:
+		t.Fatal("expected tx1 from the recent transactions")
+	}
+	status, err := tx1.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.BlockNumber != 1 || status.ValidationCode != pb.TxValidationCode_ENDORSEMENT_POLICY_FAILURE {
+		t.Fatalf("unexpected status %+v", status)
+	}
+}
+
+func TestCommitNotifierPool(t *testing.T) {
+	pool := NewCommitNotifierPool(20 * time.Millisecond)
+	defer pool.Close()
+	created, released := 0, make(chan struct{}, 4)
+	create := func() (*DeliverCommitNotifier, func(), error) {
+		created++
+		peer := &filteredPeer{blocks: make(chan *pb.FilteredBlock)}
+		n, err := NewDeliverCommitNotifier(testSigner(t), []event.DeliverPeer{peer}, "mychannel", event.Start{})
+		return n, func() { released <- struct{}{} }, err
+	}
+
+	first, err := pool.Get("peer0|mychannel", create)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := pool.Get("peer0|mychannel", create)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second || created != 1 {
+		t.Fatalf("expected the notifier to be shared, created %d", created)
+	}
+
+	// 已经停止的通知器被替换
+	first.(*DeliverCommitNotifier).Close()
+	third, err := pool.Get("peer0|mychannel", create)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third == first || created != 2 {
+		t.Fatalf("expected a new notifier, created %d", created)
+	}
+
+	// 有等待者时不会因空闲而关闭
+	tx := NewTransaction("tx1", third)
+	time.Sleep(60 * time.Millisecond)
+	if pool.Len() != 1 {
+		t.Fatal("expected notifier with waiters to be kept")
+	}
+	tx.Cancel()
+	deadline := time.After(5 * time.Second)
+	for pool.Len() != 0 {
+		select {
+		case <-deadline:
+			t.Fatal("expected idle notifier to be closed")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case <-released:
+		case <-deadline:
+			t.Fatal("expected notifiers to be released")
+		}
+	}
+}
+
+type okEndorser struct {
+	pb.EndorserClient
+}
+
+func (e *okEndorser) ProcessProposal(context.Context, *pb.SignedProposal, ...grpc.CallOption) (*pb.ProposalResponse, error) {
+	return &pb.ProposalResponse{Response: &pb.Response{Status: 200}, Payload: []byte("result"), Endorsement: &pb.Endorsement{}}, nil
+}
+
+// committingOrderer 把收到的每个交易放进下一个区块发送给 peer
+type committingOrderer struct {
+	orderer.Client
+	peer   *filteredPeer
+	number uint64
+}
+
+func (o *committingOrderer) GetBroadcastClient(context.Context) (orderer.BroadcastClient, error) {
+	return o, nil
+}
+
+func (o *committingOrderer) Send(env *cb.Envelope) error {
+	payload, err := utils.UnmarshalPayload(env.Payload)
+	if err != nil {
+		return err
+	}
+	chdr, err := utils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return err
+	}
+	o.number++
+	o.peer.blocks <- &pb.FilteredBlock{Number: o.number, FilteredTransactions: []*pb.FilteredTransaction{
+		{Txid: chdr.TxId, TxValidationCode: pb.TxValidationCode_VALID},
+	}}
+	return nil
+}
+
+func (o *committingOrderer) Close() error { return nil }
+
+func TestInvokeSharesCommitNotifier(t *testing.T) {
+	signer := testSigner(t)
+	peer := &filteredPeer{blocks: make(chan *pb.FilteredBlock, 1)}
+	ord := &committingOrderer{peer: peer}
+	pool := NewCommitNotifierPool(time.Minute)
+	defer pool.Close()
+	create := func() (*DeliverCommitNotifier, func(), error) {
+		n, err := NewDeliverCommitNotifier(signer, []event.DeliverPeer{peer}, "mychannel", event.Start{Type: event.StartBlock, BlockNumber: 1})
+		return n, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for i := 0; i < 2; i++ {
+		notifier, err := pool.Get("peer0|mychannel", create)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cf := &CommonFactory{Endorsers: []pb.EndorserClient{&okEndorser{}}, OClient: ord, Notifiers: []CommitNotifier{notifier}}
+		spec := ChaincodeSpec{Name: "mycc", Args: [][]byte{[]byte("invoke")}, Timeout: 5 * time.Second}
+		if _, err := Invoke(ctx, signer, cf, spec, "mychannel"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if streams := atomic.LoadInt32(&peer.streams); streams != 1 {
+		t.Fatalf("expected invokes to share one deliver stream, got %d", streams)
+	}
+}
@@ -11,6 +11,8 @@ func WithEndorsePlugin(plugin string) Option {
 			v.EndorserPlugin = plugin
 		case *CommitChaincodeRequest:
 			v.EndorsementPlugin = plugin
+		case *CheckCommitReadinessRequest:
+			v.EndorsementPlugin = plugin
 		}
 		return obj
 	}
@@ -24,6 +26,8 @@ func WithValidatePlugin(plugin string) Option {
 			v.ValidationPlugin = plugin
 		case *CommitChaincodeRequest:
 			v.ValidationPlugin = plugin
+		case *CheckCommitReadinessRequest:
+			v.ValidationPlugin = plugin
 		}
 		return obj
 	}
@@ -37,6 +41,8 @@ func WithSignPolicy(policy string) Option {
 			v.SignPolicy = policy
 		case *CommitChaincodeRequest:
 			v.SignPolicy = policy
+		case *CheckCommitReadinessRequest:
+			v.SignPolicy = policy
 		}
 		return obj
 	}
@@ -50,6 +56,8 @@ func WithChannelPolicy(policy string) Option {
 			v.ChannelConfigPolicy = policy
 		case *CommitChaincodeRequest:
 			v.ChannelConfigPolicy = policy
+		case *CheckCommitReadinessRequest:
+			v.ChannelConfigPolicy = policy
 		}
 		return obj
 	}
@@ -63,6 +71,8 @@ func WithCollectionConfig(config []byte) Option {
 			v.CollectionConfig = config
 		case *CommitChaincodeRequest:
 			v.CollectionConfig = config
+		case *CheckCommitReadinessRequest:
+			v.CollectionConfig = config
 		}
 		return obj
 	}
@@ -78,6 +88,8 @@ func WithSequence(seqNum int64) Option {
 			v.Sequence = seqNum
 		case *CheckCommitReadinessRequest:
 			v.Sequence = seqNum
+		case *QueryApprovedChaincodeRequest:
+			v.Sequence = seqNum
 		}
 		return obj
 	}
@@ -116,8 +128,19 @@ func WithName(name string) Option {
 		switch v := obj.(type) {
 		case *QueryCommittedChaincodeRequest:
 			v.Name = name
+		case *CheckCommitReadinessRequest:
+			v.Name = name
 		}
 		return obj
 	}
 }
 
+func WithVersion(version string) Option {
+	return func(obj interface{}) interface{} {
+		switch v := obj.(type) {
+		case *CheckCommitReadinessRequest:
+			v.Version = version
+		}
+		return obj
+	}
+}
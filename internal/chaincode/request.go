@@ -58,8 +58,15 @@ type CheckCommitReadinessRequest struct {
 	ValidationParameter  []byte
 	InitRequired         bool
 	ChannelID string
+	SignPolicy           string
+	ChannelConfigPolicy  string
+	CollectionConfig     []byte
 }
 
 type QueryCommittedChaincodeRequest struct {
 	LifecycleArgs
 }
+
+type QueryApprovedChaincodeRequest struct {
+	Sequence int64
+}
@@ -0,0 +1,296 @@
+package chaincode
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	lb "github.com/hyperledger/fabric-protos-go/peer/lifecycle"
+	"github.com/pkg/errors"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// gitTimeout 克隆和检出仓库的超时时间
+	gitTimeout = 5 * time.Minute
+	// maxSourceSize 源码tar.gz解压后的最大字节数
+	maxSourceSize = 100 << 20
+)
+
+type chaincodeFromSource struct {
+	source []byte
+	path   string
+	label  string
+	lang   string
+}
+
+// GetChaincodeInstallerFromSource 源码安装，source 为链码目录的tar.gz，path 为链码在目录中的相对路径
+func GetChaincodeInstallerFromSource(source []byte, path, label, lang string) ChaincodeInstaller {
+	return &chaincodeFromSource{source: source, path: path, label: label, lang: lang}
+}
+
+func (cc *chaincodeFromSource) GetInstalledChaincode() (*lb.InstallChaincodeArgs, error) {
+	if len(cc.source) == 0 {
+		return nil, errors.New("chaincode source is empty")
+	}
+	dir, err := ioutil.TempDir("", "chaincode-source")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	if err := extractTarGz(cc.source, dir, maxSourceSize); err != nil {
+		return nil, errors.WithMessage(err, "extract chaincode source")
+	}
+	return installFromDir(dir, cc.path, cc.label, cc.lang)
+}
+
+var (
+	gitRepoMutex sync.RWMutex
+	// gitRepoRoot 允许克隆的本地仓库所在的目录，为空时不能从git仓库安装
+	gitRepoRoot string
+)
+
+// SetGitRepoRoot 设置允许克隆的本地仓库所在的目录，解析符号链接后不在 root 之下的仓库被拒绝，
+// root 为空时不能从git仓库安装
+func SetGitRepoRoot(root string) error {
+	if root != "" {
+		abs, err := filepath.Abs(root)
+		if err != nil {
+			return errors.Wrapf(err, "invalid git repository root %s", root)
+		}
+		if root, err = filepath.EvalSymlinks(abs); err != nil {
+			return errors.Wrapf(err, "invalid git repository root %s", abs)
+		}
+	}
+	gitRepoMutex.Lock()
+	defer gitRepoMutex.Unlock()
+	gitRepoRoot = root
+	return nil
+}
+
+type chaincodeFromGitRepo struct {
+	repo  string
+	ref   string
+	path  string
+	label string
+	lang  string
+}
+
+// GetChaincodeInstallerFromGitRepo 从git仓库安装源码，只支持 SetGitRepoRoot 目录下的本地路径或者 file:// 仓库；
+// ref 为分支、标签或者提交，为空时使用默认分支，path 为链码在仓库中的相对路径
+func GetChaincodeInstallerFromGitRepo(repo, ref, path, label, lang string) ChaincodeInstaller {
+	return &chaincodeFromGitRepo{repo: repo, ref: ref, path: path, label: label, lang: lang}
+}
+
+func (cc *chaincodeFromGitRepo) GetInstalledChaincode() (*lb.InstallChaincodeArgs, error) {
+	repo, err := checkLocalRepo(cc.repo)
+	if err != nil {
+		return nil, err
+	}
+	// 以 - 开头的 ref 会被git当作选项
+	if strings.HasPrefix(cc.ref, "-") {
+		return nil, errors.Errorf("invalid git ref %q", cc.ref)
+	}
+	dir, err := ioutil.TempDir("", "chaincode-git")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithTimeout(context.Background(), gitTimeout)
+	defer cancel()
+	if err := runGit(ctx, "", "clone", "--quiet", "--no-hardlinks", "--", repo, dir); err != nil {
+		return nil, errors.WithMessagef(err, "clone %s", cc.repo)
+	}
+	if cc.ref != "" {
+		if err := runGit(ctx, dir, "checkout", "--quiet", "--detach", cc.ref); err != nil {
+			return nil, errors.WithMessagef(err, "checkout %s", cc.ref)
+		}
+	}
+	return installFromDir(dir, cc.path, cc.label, cc.lang)
+}
+
+// installFromDir 打包目录中 path 处的链码
+func installFromDir(dir, path, label, lang string) (*lb.InstallChaincodeArgs, error) {
+	ccPath, err := joinInside(dir, path)
+	if err != nil {
+		return nil, err
+	}
+	if fi, err := os.Stat(ccPath); err != nil || !fi.IsDir() {
+		return nil, errors.Errorf("chaincode path %q is not a directory", path)
+	}
+	metadataPath := path
+	if metadataPath == "" {
+		metadataPath = "."
+	}
+	cc := &chaincodeFromPath{ccPath: ccPath, label: label, lang: lang, metadataPath: metadataPath}
+	return cc.GetInstalledChaincode()
+}
+
+// checkLocalRepo 只允许 gitRepoRoot 目录下的本地仓库，避免网关访问任意的远程地址或者本地目录，
+// 返回解析符号链接后仓库的路径
+func checkLocalRepo(repo string) (string, error) {
+	if repo == "" {
+		return "", errors.New("git repository is required")
+	}
+	gitRepoMutex.RLock()
+	root := gitRepoRoot
+	gitRepoMutex.RUnlock()
+	if root == "" {
+		return "", errors.New("installing chaincode from git repository is disabled")
+	}
+	path := repo
+	if strings.Contains(repo, "://") {
+		u, err := url.Parse(repo)
+		if err != nil {
+			return "", errors.Wrapf(err, "invalid git repository %q", repo)
+		}
+		if u.Scheme != "file" {
+			return "", errors.Errorf("unsupported git repository %q, only local path or file:// is supported", repo)
+		}
+		path = u.Path
+	} else if strings.HasPrefix(repo, "-") || (strings.Contains(repo, ":") && !filepath.IsAbs(repo)) {
+		return "", errors.Errorf("unsupported git repository %q, only local path or file:// is supported", repo)
+	}
+	path, err := filepath.Abs(path)
+	if err == nil {
+		path, err = filepath.EvalSymlinks(path)
+	}
+	if err != nil {
+		return "", errors.Errorf("git repository %q is not a local directory", repo)
+	}
+	if fi, err := os.Stat(path); err != nil || !fi.IsDir() {
+		return "", errors.Errorf("git repository %q is not a local directory", repo)
+	}
+	rel, err := filepath.Rel(root, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errors.Errorf("git repository %q is outside of %s", repo, root)
+	}
+	return path, nil
+}
+
+func runGit(ctx context.Context, dir string, args ...string) error {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "git %s: %s", args[0], strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// joinInside 拼接相对路径，不能跳出 dir
+func joinInside(dir, name string) (string, error) {
+	path := filepath.Join(dir, filepath.FromSlash(name))
+	rel, err := filepath.Rel(dir, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", errors.Errorf("path %q is outside of the chaincode source", name)
+	}
+	return path, nil
+}
+
+// extractTarGz 把tar.gz解压到 dir，只解压目录和普通文件，解压后超过 limit 字节时返回错误
+func extractTarGz(data []byte, dir string, limit int64) error {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return errors.Wrap(err, "read gzip")
+	}
+	defer gr.Close()
+	lr := &io.LimitedReader{R: gr, N: limit + 1}
+	tr := tar.NewReader(lr)
+	for {
+		header, err := tr.Next()
+		if lr.N <= 0 {
+			return errors.Errorf("chaincode source exceeds %d bytes", limit)
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "read tar")
+		}
+		target, err := joinInside(dir, header.Name)
+		if err != nil {
+			return err
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			f, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(f, tr)
+			f.Close()
+			if lr.N <= 0 {
+				return errors.Errorf("chaincode source exceeds %d bytes", limit)
+			}
+			if err != nil {
+				return errors.Wrapf(err, "extract %s", header.Name)
+			}
+		}
+	}
+}
+
+// sourceTree 把链码目录中的文件打包到 src/ 下，用于node和java链码
+type sourceTree struct{}
+
+func (sourceTree) NormalizePath(path string) (string, error) {
+	return path, nil
+}
+
+func (sourceTree) GetDeploymentPayload(path string) ([]byte, error) {
+	payload := bytes.NewBuffer(nil)
+	gw := gzip.NewWriter(payload)
+	tw := tar.NewWriter(gw)
+	err := filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(info.Name(), ".") && file != path {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(path, file)
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		return writeBytesToPackage(tw, filepath.ToSlash(filepath.Join("src", rel)), data)
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "package chaincode in %s", path)
+	}
+	err = tw.Close()
+	if err == nil {
+		err = gw.Close()
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create tar for chaincode")
+	}
+	return payload.Bytes(), nil
+}
@@ -0,0 +1,193 @@
+package chaincode
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// readTarGz 读取tar.gz中的文件
+func readTarGz(t *testing.T, data []byte) map[string][]byte {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gr)
+	files := make(map[string][]byte)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if header.Typeflag == tar.TypeDir {
+			continue
+		}
+		content, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[header.Name] = content
+	}
+}
+
+func writeTarGz(t *testing.T, files map[string]string) []byte {
+	buf := bytes.NewBuffer(nil)
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+	for name, content := range files {
+		if err := writeBytesToPackage(tw, name, []byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// readInstallPackage 返回安装包的元数据和代码包中的文件
+func readInstallPackage(t *testing.T, installer ChaincodeInstaller) (*PackageMetadata, map[string][]byte) {
+	args, err := installer.GetInstalledChaincode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := readTarGz(t, args.ChaincodeInstallPackage)
+	metadata := &PackageMetadata{}
+	if err := json.Unmarshal(pkg["metadata.json"], metadata); err != nil {
+		t.Fatal(err)
+	}
+	return metadata, readTarGz(t, pkg["code.tar.gz"])
+}
+
+func TestInstallFromSource(t *testing.T) {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go is not installed")
+	}
+	source := writeTarGz(t, map[string]string{
+		"chaincode/go.mod":  "module example.com/sacc\n\ngo 1.15\n",
+		"chaincode/main.go": "package main\n\nfunc main() {}\n",
+		"README.md":         "sacc\n",
+	})
+	metadata, code := readInstallPackage(t, GetChaincodeInstallerFromSource(source, "chaincode", "sacc_1", "golang"))
+	if metadata.Path != "example.com/sacc" || metadata.Type != "golang" || metadata.Label != "sacc_1" {
+		t.Fatalf("unexpected metadata %+v", metadata)
+	}
+	if _, ok := code["src/main.go"]; !ok {
+		t.Fatalf("main.go is not packaged: %v", code)
+	}
+
+	bad := writeTarGz(t, map[string]string{"../escape.go": "package main\n"})
+	if _, err := GetChaincodeInstallerFromSource(bad, ".", "sacc_1", "golang").GetInstalledChaincode(); err == nil {
+		t.Fatal("expected error of path outside of the source")
+	}
+}
+
+func TestInstallFromGitRepo(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	root, err := ioutil.TempDir("", "chaincode-repos")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	repo := filepath.Join(root, "marbles")
+	if err := os.Mkdir(repo, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = repo
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %s", args, output)
+		}
+	}
+	write := func(content string) {
+		if err := os.MkdirAll(filepath.Join(repo, "node"), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(repo, "node", "index.js"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	git("init", "--quiet")
+	write("v1")
+	git("add", "-A")
+	git("commit", "--quiet", "-m", "v1")
+	git("tag", "v1")
+	write("v2")
+	git("commit", "--quiet", "-am", "v2")
+
+	if _, err := GetChaincodeInstallerFromGitRepo(repo, "", "node", "marbles_0", "node").GetInstalledChaincode(); err == nil || !strings.Contains(err.Error(), "disabled") {
+		t.Fatal("expected error without git repository root")
+	}
+	if err := SetGitRepoRoot(root); err != nil {
+		t.Fatal(err)
+	}
+	defer SetGitRepoRoot("")
+
+	metadata, code := readInstallPackage(t, GetChaincodeInstallerFromGitRepo("file://"+repo, "v1", "node", "marbles_1", "node"))
+	if metadata.Path != "node" || metadata.Type != "node" {
+		t.Fatalf("unexpected metadata %+v", metadata)
+	}
+	if string(code["src/index.js"]) != "v1" {
+		t.Fatalf("unexpected source %q", code["src/index.js"])
+	}
+	_, code = readInstallPackage(t, GetChaincodeInstallerFromGitRepo(repo, "", "node", "marbles_2", "node"))
+	if string(code["src/index.js"]) != "v2" {
+		t.Fatalf("unexpected source %q", code["src/index.js"])
+	}
+
+	if _, err := GetChaincodeInstallerFromGitRepo("https://example.com/cc.git", "", "", "cc_1", "node").GetInstalledChaincode(); err == nil {
+		t.Fatal("expected error of remote repository")
+	}
+	if _, err := GetChaincodeInstallerFromGitRepo(repo, "--upload-pack=touch", "node", "marbles_3", "node").GetInstalledChaincode(); err == nil {
+		t.Fatal("expected error of ref starting with -")
+	}
+
+	// 符号链接指向 root 之外的仓库
+	outside, err := ioutil.TempDir("", "chaincode-outside")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outside)
+	if _, err := GetChaincodeInstallerFromGitRepo(outside, "", "", "outside_1", "node").GetInstalledChaincode(); err == nil || !strings.Contains(err.Error(), "outside") {
+		t.Fatal("expected error of repository outside of the root")
+	}
+	if err := os.Symlink(outside, filepath.Join(root, "link")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := GetChaincodeInstallerFromGitRepo(filepath.Join(root, "link"), "", "", "outside_2", "node").GetInstalledChaincode(); err == nil || !strings.Contains(err.Error(), "outside") {
+		t.Fatal("expected error of symlink to a repository outside of the root")
+	}
+}
+
+func TestExtractTarGzLimit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chaincode-source")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	source := writeTarGz(t, map[string]string{"main.go": string(make([]byte, 64*1024))})
+	if err := extractTarGz(source, dir, 1024*1024); err != nil {
+		t.Fatal(err)
+	}
+	if err := extractTarGz(source, dir, 16*1024); err == nil {
+		t.Fatal("expected error of source exceeding the limit")
+	}
+}
@@ -0,0 +1,130 @@
+package chaincode
+
+import (
+	"context"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/pkg/errors"
+	"sync"
+)
+
+// CommitStatus 交易的提交结果
+type CommitStatus struct {
+	TxID           string
+	ValidationCode pb.TxValidationCode
+	BlockNumber    uint64
+	// Peer 报告提交结果的节点
+	Peer string
+}
+
+// Err 交易无效时返回 sdkerrors.TxInvalidated，有效时返回nil
+func (s *CommitStatus) Err() error {
+	if s.ValidationCode == pb.TxValidationCode_VALID {
+		return nil
+	}
+	return sdkerrors.TxInvalidated(s.TxID, s.Peer, s.ValidationCode)
+}
+
+// CommitNotifier 在交易提交后通知等待者
+type CommitNotifier interface {
+	// Register 注册等待交易 txID，交易提交或者通知器出错时调用一次 done，须在交易发送给排序节点之前注册。
+	// 返回的函数取消等待，之后 done 不会再被调用
+	Register(txID string, done func(*CommitStatus, error)) (cancel func())
+}
+
+// Transaction 已发送给排序节点的交易，通过 Status 或者 Done 获取提交结果
+type Transaction struct {
+	txID     string
+	response *pb.Response
+	done     chan struct{}
+	status   *CommitStatus
+	err      error
+	once     sync.Once
+	cancel   func()
+}
+
+// NewTransaction 通过 notifier 等待已发送的交易 txID 提交，notifier 为nil时不等待，Status 返回错误
+func NewTransaction(txID string, notifier CommitNotifier) *Transaction {
+	tx := &Transaction{txID: txID, done: make(chan struct{})}
+	if notifier == nil {
+		tx.finish(nil, errors.Errorf("txid %s is submitted without a commit notifier", txID))
+		return tx
+	}
+	tx.cancel = notifier.Register(txID, tx.finish)
+	return tx
+}
+
+// TxID 交易ID
+func (t *Transaction) TxID() string {
+	return t.txID
+}
+
+// Response 背书节点的响应，NewTransaction 创建的交易为nil
+func (t *Transaction) Response() *pb.Response {
+	return t.response
+}
+
+// Done 交易提交或者等待出错后关闭
+func (t *Transaction) Done() <-chan struct{} {
+	return t.done
+}
+
+// Status 等待交易提交，返回交易的验证结果和所在区块。交易无效时不返回错误，由调用方检查 CommitStatus.Err
+func (t *Transaction) Status(ctx context.Context) (*CommitStatus, error) {
+	select {
+	case <-t.done:
+		return t.status, t.err
+	case <-ctx.Done():
+		return nil, sdkerrors.TimedOut(ctx.Err(), "timed out waiting for txid %s", t.txID)
+	}
+}
+
+// Cancel 不再等待交易提交，Status 返回错误
+func (t *Transaction) Cancel() {
+	if t.cancel != nil {
+		t.cancel()
+	}
+	t.finish(nil, errors.Errorf("waiting for txid %s is canceled", t.txID))
+}
+
+func (t *Transaction) finish(status *CommitStatus, err error) {
+	t.once.Do(func() {
+		t.status, t.err = status, err
+		close(t.done)
+	})
+}
+
+// Submit 背书后把交易发送给排序节点即返回，不等待交易提交，通过返回的 Transaction 获取提交结果。
+// notifier 为nil时只发送交易，可以之后通过 NewTransaction 等待
+func Submit(ctx context.Context, signer cryptoutil.Signer, cf *CommonFactory, spec ChaincodeSpec, channelID string, notifier CommitNotifier) (*Transaction, error) {
+	proposal, txID, err := createInvocationProposal(signer, spec, channelID)
+	if err != nil {
+		return nil, err
+	}
+	signedProp, err := cryptoutil.GetSignedProposal(proposal, signer)
+	if err != nil {
+		return nil, err
+	}
+	proposalResps, err := processProposal(ctx, signedProp, cf)
+	if err != nil {
+		return nil, err
+	}
+	if len(proposalResps) == 0 {
+		return nil, errors.New("no proposal responses received - this might indicate a bug")
+	}
+	response := proposalResps[0].Response
+	if response.Status >= shim.ERRORTHRESHOLD {
+		return nil, sdkerrors.EndorsementFailed(cf.peerAddress(0), response.Status, response.Message)
+	}
+
+	// 先注册再发送，避免交易在注册之前提交
+	tx := NewTransaction(txID, notifier)
+	tx.response = response
+	if err := broadcastProposalEnvelope(ctx, signer, proposal, cf, channelID, txID, 0, proposalResps...); err != nil {
+		tx.Cancel()
+		return nil, err
+	}
+	return tx, nil
+}
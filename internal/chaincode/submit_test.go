@@ -0,0 +1,101 @@
+package chaincode
+
+import (
+	"context"
+	"crypto/tls"
+	"github.com/godzilla-s/fabricsdk-go/internal/event"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"google.golang.org/grpc"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// filteredPeer 只提供 DeliverFiltered，发送完 blocks 后保持连接直到取消
+type filteredPeer struct {
+	pb.DeliverClient
+	blocks chan *pb.FilteredBlock
+	// streams 建立的 DeliverFiltered 连接数
+	streams int32
+}
+
+func (p *filteredPeer) GetDeliverClient(context.Context) (pb.DeliverClient, error) { return p, nil }
+
+func (p *filteredPeer) GetCertificate() tls.Certificate { return tls.Certificate{} }
+
+func (p *filteredPeer) GetAddress() string { return "peer0" }
+
+func (p *filteredPeer) DeliverFiltered(ctx context.Context, opts ...grpc.CallOption) (pb.Deliver_DeliverFilteredClient, error) {
+	atomic.AddInt32(&p.streams, 1)
+	return &filteredStream{ctx: ctx, blocks: p.blocks}, nil
+}
+
+type filteredStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	blocks chan *pb.FilteredBlock
+}
+
+func (s *filteredStream) Send(*cb.Envelope) error { return nil }
+
+func (s *filteredStream) Recv() (*pb.DeliverResponse, error) {
+	select {
+	case block := <-s.blocks:
+		return &pb.DeliverResponse{Type: &pb.DeliverResponse_FilteredBlock{FilteredBlock: block}}, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func TestDeliverCommitNotifier(t *testing.T) {
+	peer := &filteredPeer{blocks: make(chan *pb.FilteredBlock, 1)}
+	notifier, err := NewDeliverCommitNotifier(testSigner(t), []event.DeliverPeer{peer}, "mychannel", event.Start{Type: event.StartBlock, BlockNumber: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx1, tx1Again := NewTransaction("tx1", notifier), NewTransaction("tx1", notifier)
+	tx2, tx3 := NewTransaction("tx2", notifier), NewTransaction("tx3", notifier)
+	canceled := NewTransaction("tx1", notifier)
+	canceled.Cancel()
+
+	peer.blocks <- &pb.FilteredBlock{Number: 3, FilteredTransactions: []*pb.FilteredTransaction{
+		{Txid: "tx1", TxValidationCode: pb.TxValidationCode_VALID},
+		{Txid: "tx2", TxValidationCode: pb.TxValidationCode_MVCC_READ_CONFLICT},
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for _, tx := range []*Transaction{tx1, tx1Again} {
+		status, err := tx.Status(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if status.BlockNumber != 3 || status.Peer != "peer0" || status.Err() != nil {
+			t.Fatalf("unexpected status %+v", status)
+		}
+	}
+	status, err := tx2.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sdkerrors.Is(status.Err(), sdkerrors.MVCCConflict) {
+		t.Fatalf("expected MVCC conflict, got %v", status.Err())
+	}
+	if _, err := canceled.Status(ctx); err == nil {
+		t.Fatal("expected error of canceled transaction")
+	}
+
+	select {
+	case <-tx3.Done():
+		t.Fatal("tx3 is not committed")
+	default:
+	}
+	notifier.Close()
+	if _, err := tx3.Status(ctx); err == nil {
+		t.Fatal("expected error of closed notifier")
+	}
+	if _, err := NewTransaction("tx4", notifier).Status(ctx); err == nil {
+		t.Fatal("expected error of closed notifier")
+	}
+}
@@ -0,0 +1,98 @@
+package channel
+
+import (
+	"github.com/hyperledger/fabric-config/configtx"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	"github.com/pkg/errors"
+)
+
+// AnchorPeerAction 锚节点的修改方式
+type AnchorPeerAction int
+
+const (
+	// SetAnchorPeers 替换组织的全部锚节点，为空时清除
+	SetAnchorPeers AnchorPeerAction = iota
+	// AddAnchorPeers 添加锚节点，已存在的忽略
+	AddAnchorPeers
+	// RemoveAnchorPeers 删除锚节点，不存在时返回错误
+	RemoveAnchorPeers
+)
+
+func (a AnchorPeerAction) String() string {
+	switch a {
+	case SetAnchorPeers:
+		return "set"
+	case AddAnchorPeers:
+		return "add"
+	case RemoveAnchorPeers:
+		return "remove"
+	}
+	return "unknown"
+}
+
+// AnchorPeersUpdate 修改通道中应用组织的锚节点，锚节点的修改策略为组织的Admins，只需组织管理员签名
+func AnchorPeersUpdate(lastConfigBlock *cb.Block, orgName string, action AnchorPeerAction, peers []AnchorPeer, channelID string) (*UpdateEnvelope, error) {
+	config, err := getBlockConfig(lastConfigBlock)
+	if err != nil {
+		return nil, err
+	}
+	configTx := configtx.New(config)
+	if configTx.Application() == nil {
+		return nil, errors.Errorf("channel %s has no application group", channelID)
+	}
+	org := configTx.Application().Organization(orgName)
+	if org == nil {
+		return nil, errors.Errorf("organization %s not exist in channel %s", orgName, channelID)
+	}
+
+	existing, err := org.AnchorPeers()
+	if err != nil {
+		return nil, errors.WithMessagef(err, "anchor peers of organization %s", orgName)
+	}
+	switch action {
+	case SetAnchorPeers:
+		for _, ap := range existing {
+			if err := org.RemoveAnchorPeer(ap); err != nil {
+				return nil, err
+			}
+		}
+		fallthrough
+	case AddAnchorPeers:
+		for _, ap := range peers {
+			if err := validateAnchorPeer(ap); err != nil {
+				return nil, err
+			}
+			if err := org.AddAnchorPeer(configtx.Address{Host: ap.Host, Port: ap.Port}); err != nil {
+				return nil, errors.WithMessagef(err, "add anchor peer %s:%d", ap.Host, ap.Port)
+			}
+		}
+	case RemoveAnchorPeers:
+		for _, ap := range peers {
+			if err := org.RemoveAnchorPeer(configtx.Address{Host: ap.Host, Port: ap.Port}); err != nil {
+				return nil, errors.WithMessagef(err, "remove anchor peer %s:%d", ap.Host, ap.Port)
+			}
+		}
+	default:
+		return nil, errors.Errorf("unknown anchor peer action %d", action)
+	}
+
+	update, err := configTx.ComputeMarshaledUpdate(channelID)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "%s anchor peers of organization %s", action, orgName)
+	}
+	return &UpdateEnvelope{
+		update:     update,
+		channelID:  channelID,
+		signatures: make(map[string]*cb.ConfigSignature),
+	}, nil
+}
+
+func validateAnchorPeer(ap AnchorPeer) error {
+	if ap.Host == "" {
+		return errors.New("anchor peer host is empty")
+	}
+	if ap.Port <= 0 || ap.Port > 65535 {
+		return errors.Errorf("invalid port %d of anchor peer %s", ap.Port, ap.Host)
+	}
+	return nil
+}
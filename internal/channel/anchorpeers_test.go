@@ -0,0 +1,60 @@
+package channel
+
+import (
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"testing"
+)
+
+func TestAnchorPeersUpdate(t *testing.T) {
+	block := testAppChannelBlock(t)
+	peers := []AnchorPeer{{Host: "peer0.org1.example.com", Port: 7051}, {Host: "peer1.org1.example.com", Port: 7051}}
+	env, err := AnchorPeersUpdate(block, "org1", SetAnchorPeers, peers, "mychannel")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	configUpdate := &cb.ConfigUpdate{}
+	if err := proto.Unmarshal(env.GetUpdates(), configUpdate); err != nil {
+		t.Fatal(err)
+	}
+	value := configUpdate.WriteSet.Groups[ApplicationGroupKey].Groups["org1"].Values["AnchorPeers"]
+	if value == nil {
+		t.Fatal("anchor peers are not in the write set")
+	}
+	anchorPeers := &pb.AnchorPeers{}
+	if err := proto.Unmarshal(value.Value, anchorPeers); err != nil {
+		t.Fatal(err)
+	}
+	if len(anchorPeers.AnchorPeers) != 2 || anchorPeers.AnchorPeers[0].Host != "peer0.org1.example.com" {
+		t.Fatalf("unexpected anchor peers %v", anchorPeers.AnchorPeers)
+	}
+
+	// 锚节点只需要所在组织的管理员签名
+	sigs := map[string][]byte{"Org1MSP": signConfig(t, testAdminSigner(t, "org1", "Org1MSP"), env.GetUpdates())}
+	identities, err := VerifyConfigSignatures(block, env.GetUpdates(), sigs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsatisfied, err := CheckConfigUpdatePolicies(block, env.GetUpdates(), identities)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unsatisfied) != 0 {
+		t.Fatalf("unexpected unsatisfied policies %v", unsatisfied)
+	}
+}
+
+func TestAnchorPeersUpdateInvalid(t *testing.T) {
+	block := testAppChannelBlock(t)
+	if _, err := AnchorPeersUpdate(block, "org3", AddAnchorPeers, []AnchorPeer{{Host: "peer0.org3.example.com", Port: 7051}}, "mychannel"); err == nil {
+		t.Fatal("expected error of unknown organization")
+	}
+	if _, err := AnchorPeersUpdate(block, "org1", AddAnchorPeers, []AnchorPeer{{Host: "peer0.org1.example.com"}}, "mychannel"); err == nil {
+		t.Fatal("expected error of invalid port")
+	}
+	if _, err := AnchorPeersUpdate(block, "org1", RemoveAnchorPeers, []AnchorPeer{{Host: "peer0.org1.example.com", Port: 7051}}, "mychannel"); err == nil {
+		t.Fatal("expected error of removing an anchor peer not set")
+	}
+}
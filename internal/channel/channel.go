@@ -107,9 +107,21 @@ func CreateApplicationChannel(channelID, consortiumName string, orgs []Organizat
 }
 
 func CreateSystemGenesisBlock(ordererOrg Organization, peerOrgs []Organization, consortiumName, sysChannelID string) (*cb.Block, error) {
+	consenters, err := etcdRaftConsenters(ordererOrg)
+	if err != nil {
+		return nil, err
+	}
+	org, err := ordererOrg.CreateOrganization()
+	if err != nil {
+		return nil, err
+	}
 
 	orderer := configtx.Orderer{
 		OrdererType: orderer.ConsensusTypeEtcdRaft,
+		EtcdRaft: orderer.EtcdRaft{
+			Consenters: consenters,
+			Options:    defaultEtcdRaftOptions,
+		},
 		BatchTimeout: 2*time.Second,
 		Capabilities: []string{"V2_0"},
 		BatchSize: orderer.BatchSize{
@@ -135,7 +147,7 @@ func CreateSystemGenesisBlock(ordererOrg Organization, peerOrgs []Organization,
 				Rule: "ANY Writers",
 			},
 		},
-		Organizations: []configtx.Organization{},
+		Organizations: []configtx.Organization{org},
 		State: orderer.ConsensusStateNormal,
 	}
 
@@ -16,6 +16,7 @@ const (
 	CSCC_GetChainInfo = "GetChainInfo"
 	CSCC_GetChannels = "GetChannels"
 
+	QSCC = "qscc"
 	QSCC_GetBlockByHash = "GetBlockByHash"
 	QSCC_GetBlockByNumber = "GetBlockByNumber"
 	QSCC_GetBlockByTxID = "GetBlockByTxID"
@@ -107,9 +108,9 @@ func (o Organization) CreateOrganization() (configtx.Organization, error) {
 	}
 	switch o.Type {
 	case protoutil.Organization_PEER:
-		org.Policies = getOrdererOrgStandardRWPolicy(o.ID)
-	case protoutil.Organization_ORDERER:
 		org.Policies = getPeerOrgStandardRWPolicies(o.ID)
+	case protoutil.Organization_ORDERER:
+		org.Policies = getOrdererOrgStandardRWPolicy(o.ID)
 	default:
 		return org, fmt.Errorf("organization type is required")
 	}
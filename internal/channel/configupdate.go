@@ -0,0 +1,387 @@
+package channel
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"github.com/hyperledger/fabric-config/configtx"
+	"github.com/hyperledger/fabric-config/configtx/orderer"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// ConfigGroup 配置组
+type ConfigGroup int32
+
+const (
+	ChannelGroup ConfigGroup = iota
+	ApplicationGroup
+	OrdererGroup
+	ConsortiumGroup
+)
+
+func (g ConfigGroup) String() string {
+	switch g {
+	case ChannelGroup:
+		return "Channel"
+	case ApplicationGroup:
+		return ApplicationGroupKey
+	case OrdererGroup:
+		return OrdererGroupKey
+	case ConsortiumGroup:
+		return "Consortium"
+	}
+	return "Unknown"
+}
+
+// PolicyUpdate 策略修改，OrgName为空时修改配置组的策略
+type PolicyUpdate struct {
+	Group   ConfigGroup
+	OrgName string
+	Name    string
+	Policy  configtx.Policy
+	Remove  bool
+}
+
+// CapabilityUpdate 能力修改
+type CapabilityUpdate struct {
+	Group      ConfigGroup
+	Capability string
+	Remove     bool
+}
+
+// MSPUpdate 组织MSP修改
+type MSPUpdate struct {
+	Group                       ConfigGroup
+	OrgName                     string
+	AddedRootCerts              []*x509.Certificate
+	RemovedRootCerts            []*x509.Certificate
+	AddedIntermediateCerts      []*x509.Certificate
+	RemovedIntermediateCerts    []*x509.Certificate
+	AddedAdminCerts             []*x509.Certificate
+	RemovedAdminCerts           []*x509.Certificate
+	AddedTLSRootCerts           []*x509.Certificate
+	RemovedTLSRootCerts         []*x509.Certificate
+	AddedTLSIntermediateCerts   []*x509.Certificate
+	RemovedTLSIntermediateCerts []*x509.Certificate
+	CRLs                        []*pkix.CertificateList
+}
+
+// ConfigModification 通道或联盟配置修改，只修改设置的项
+type ConfigModification struct {
+	// BatchSize 为0的项不修改
+	BatchSize    orderer.BatchSize
+	BatchTimeout time.Duration
+	Policies     []PolicyUpdate
+	Capabilities []CapabilityUpdate
+	// ACLs 设置的ACL，只用于应用通道
+	ACLs        map[string]string
+	RemovedACLs []string
+	MSPs        []MSPUpdate
+}
+
+// ChannelConfigUpdate 应用通道配置更新
+func ChannelConfigUpdate(lastConfigBlock *cb.Block, mod ConfigModification, channelID string) (*UpdateEnvelope, error) {
+	return configUpdate(lastConfigBlock, mod, "", channelID)
+}
+
+// ConsortiumConfigUpdate 系统通道配置更新，联盟组的修改作用于consortiumName
+func ConsortiumConfigUpdate(lastConfigBlock *cb.Block, mod ConfigModification, consortiumName, channelID string) (*UpdateEnvelope, error) {
+	if consortiumName == "" && usesGroup(mod, ConsortiumGroup) {
+		return nil, errors.New("consortium name is required to update the consortium")
+	}
+	return configUpdate(lastConfigBlock, mod, consortiumName, channelID)
+}
+
+func configUpdate(lastConfigBlock *cb.Block, mod ConfigModification, consortiumName, channelID string) (*UpdateEnvelope, error) {
+	config, err := getBlockConfig(lastConfigBlock)
+	if err != nil {
+		return nil, err
+	}
+	configTx := configtx.New(config)
+	u := &configUpdater{configTx: &configTx, groups: config.ChannelGroup.Groups, consortiumName: consortiumName}
+	if err := u.apply(mod); err != nil {
+		return nil, err
+	}
+	update, err := configTx.ComputeMarshaledUpdate(channelID)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateEnvelope{update: update, channelID: channelID, signatures: make(map[string]*cb.ConfigSignature)}, nil
+}
+
+func usesGroup(mod ConfigModification, group ConfigGroup) bool {
+	for _, p := range mod.Policies {
+		if p.Group == group {
+			return true
+		}
+	}
+	for _, c := range mod.Capabilities {
+		if c.Group == group {
+			return true
+		}
+	}
+	for _, m := range mod.MSPs {
+		if m.Group == group {
+			return true
+		}
+	}
+	return false
+}
+
+type configUpdater struct {
+	configTx       *configtx.ConfigTx
+	groups         map[string]*cb.ConfigGroup
+	consortiumName string
+}
+
+// checkGroup 检查配置中存在该组，应用通道没有联盟组，系统通道没有应用组
+func (u *configUpdater) checkGroup(group ConfigGroup) error {
+	switch group {
+	case ChannelGroup:
+		return nil
+	case ApplicationGroup, OrdererGroup:
+		if _, ok := u.groups[group.String()]; !ok {
+			return errors.Errorf("config has no %s group", group)
+		}
+		return nil
+	case ConsortiumGroup:
+		if u.consortiumName == "" {
+			return errors.New("consortium can only be updated by a consortium config update")
+		}
+		if u.configTx.Consortium(u.consortiumName) == nil {
+			return errors.Errorf("not found consortium %s", u.consortiumName)
+		}
+		return nil
+	}
+	return errors.Errorf("unknown config group %d", group)
+}
+
+func (u *configUpdater) apply(mod ConfigModification) error {
+	batchSize := mod.BatchSize
+	if batchSize.MaxMessageCount != 0 || batchSize.AbsoluteMaxBytes != 0 || batchSize.PreferredMaxBytes != 0 || mod.BatchTimeout != 0 {
+		if err := u.checkGroup(OrdererGroup); err != nil {
+			return err
+		}
+		if err := u.setBatch(mod); err != nil {
+			return err
+		}
+	}
+	for _, p := range mod.Policies {
+		if err := u.updatePolicy(p); err != nil {
+			return errors.WithMessagef(err, "update policy %s of %s", p.Name, p.Group)
+		}
+	}
+	for _, c := range mod.Capabilities {
+		if err := u.updateCapability(c); err != nil {
+			return errors.WithMessagef(err, "update capability %s of %s", c.Capability, c.Group)
+		}
+	}
+	if len(mod.ACLs) > 0 || len(mod.RemovedACLs) > 0 {
+		if err := u.checkGroup(ApplicationGroup); err != nil {
+			return err
+		}
+		application := u.configTx.Application()
+		if len(mod.ACLs) > 0 {
+			if err := application.SetACLs(mod.ACLs); err != nil {
+				return errors.WithMessage(err, "set ACLs")
+			}
+		}
+		if len(mod.RemovedACLs) > 0 {
+			if err := application.RemoveACLs(mod.RemovedACLs); err != nil {
+				return errors.WithMessage(err, "remove ACLs")
+			}
+		}
+	}
+	for _, m := range mod.MSPs {
+		if err := u.updateMSP(m); err != nil {
+			return errors.WithMessagef(err, "update MSP of organization %s", m.OrgName)
+		}
+	}
+	return nil
+}
+
+func (u *configUpdater) setBatch(mod ConfigModification) error {
+	ord := u.configTx.Orderer()
+	batchSize := ord.BatchSize()
+	if mod.BatchSize.MaxMessageCount != 0 {
+		if err := batchSize.SetMaxMessageCount(mod.BatchSize.MaxMessageCount); err != nil {
+			return err
+		}
+	}
+	if mod.BatchSize.AbsoluteMaxBytes != 0 {
+		if err := batchSize.SetAbsoluteMaxBytes(mod.BatchSize.AbsoluteMaxBytes); err != nil {
+			return err
+		}
+	}
+	if mod.BatchSize.PreferredMaxBytes != 0 {
+		if err := batchSize.SetPreferredMaxBytes(mod.BatchSize.PreferredMaxBytes); err != nil {
+			return err
+		}
+	}
+	if mod.BatchTimeout != 0 {
+		if err := ord.SetBatchTimeout(mod.BatchTimeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// policyTarget 可修改策略的配置组或组织
+type policyTarget interface {
+	SetPolicy(policyName string, policy configtx.Policy) error
+}
+
+func (u *configUpdater) updatePolicy(p PolicyUpdate) error {
+	if err := u.checkGroup(p.Group); err != nil {
+		return err
+	}
+	if p.Name == "" {
+		return errors.New("policy name is required")
+	}
+	if p.OrgName != "" {
+		return u.updateOrgPolicy(p)
+	}
+	var target policyTarget
+	var remove func(string) error
+	switch p.Group {
+	case ChannelGroup:
+		g := u.configTx.Channel()
+		target, remove = g, g.RemovePolicy
+	case ApplicationGroup:
+		g := u.configTx.Application()
+		target, remove = g, g.RemovePolicy
+	case OrdererGroup:
+		g := u.configTx.Orderer()
+		target, remove = g, g.RemovePolicy
+	case ConsortiumGroup:
+		if p.Remove {
+			return errors.New("consortium channel creation policy can not be removed")
+		}
+		return u.configTx.Consortium(u.consortiumName).SetChannelCreationPolicy(p.Policy)
+	}
+	if p.Remove {
+		return remove(p.Name)
+	}
+	return target.SetPolicy(p.Name, p.Policy)
+}
+
+func (u *configUpdater) updateOrgPolicy(p PolicyUpdate) error {
+	switch p.Group {
+	case ApplicationGroup:
+		org := u.configTx.Application().Organization(p.OrgName)
+		if org == nil {
+			return errors.Errorf("not found organization %s", p.OrgName)
+		}
+		if p.Remove {
+			return org.RemovePolicy(p.Name)
+		}
+		return org.SetPolicy(p.Name, p.Policy)
+	case OrdererGroup:
+		org := u.configTx.Orderer().Organization(p.OrgName)
+		if org == nil {
+			return errors.Errorf("not found organization %s", p.OrgName)
+		}
+		if p.Remove {
+			return org.RemovePolicy(p.Name)
+		}
+		return org.SetPolicy(p.Name, p.Policy)
+	case ConsortiumGroup:
+		org := u.configTx.Consortium(u.consortiumName).Organization(p.OrgName)
+		if org == nil {
+			return errors.Errorf("not found organization %s", p.OrgName)
+		}
+		if p.Remove {
+			org.RemovePolicy(p.Name)
+			return nil
+		}
+		return org.SetPolicy(p.Name, p.Policy)
+	}
+	return errors.Errorf("%s group has no organizations", p.Group)
+}
+
+func (u *configUpdater) updateCapability(c CapabilityUpdate) error {
+	if err := u.checkGroup(c.Group); err != nil {
+		return err
+	}
+	if c.Capability == "" {
+		return errors.New("capability is required")
+	}
+	var add, remove func(string) error
+	switch c.Group {
+	case ChannelGroup:
+		g := u.configTx.Channel()
+		add, remove = g.AddCapability, g.RemoveCapability
+	case ApplicationGroup:
+		g := u.configTx.Application()
+		add, remove = g.AddCapability, g.RemoveCapability
+	case OrdererGroup:
+		g := u.configTx.Orderer()
+		add, remove = g.AddCapability, g.RemoveCapability
+	default:
+		return errors.Errorf("%s group has no capabilities", c.Group)
+	}
+	if c.Remove {
+		return remove(c.Capability)
+	}
+	return add(c.Capability)
+}
+
+func (u *configUpdater) orgMSP(group ConfigGroup, orgName string) (*configtx.OrganizationMSP, error) {
+	if err := u.checkGroup(group); err != nil {
+		return nil, err
+	}
+	switch group {
+	case ApplicationGroup:
+		if org := u.configTx.Application().Organization(orgName); org != nil {
+			return org.MSP(), nil
+		}
+	case OrdererGroup:
+		if org := u.configTx.Orderer().Organization(orgName); org != nil {
+			return org.MSP(), nil
+		}
+	case ConsortiumGroup:
+		if org := u.configTx.Consortium(u.consortiumName).Organization(orgName); org != nil {
+			return org.MSP(), nil
+		}
+	default:
+		return nil, errors.Errorf("%s group has no organizations", group)
+	}
+	return nil, errors.Errorf("not found organization %s in %s group", orgName, group)
+}
+
+func (u *configUpdater) updateMSP(m MSPUpdate) error {
+	msp, err := u.orgMSP(m.Group, m.OrgName)
+	if err != nil {
+		return err
+	}
+	// 先加入新的根证书，再删除旧的根证书，以便轮换时其它证书仍然有效
+	steps := []struct {
+		certs []*x509.Certificate
+		fn    func(*x509.Certificate) error
+	}{
+		{m.AddedRootCerts, msp.AddRootCert},
+		{m.AddedIntermediateCerts, msp.AddIntermediateCert},
+		{m.AddedAdminCerts, msp.AddAdminCert},
+		{m.AddedTLSRootCerts, msp.AddTLSRootCert},
+		{m.AddedTLSIntermediateCerts, msp.AddTLSIntermediateCert},
+		{m.RemovedAdminCerts, msp.RemoveAdminCert},
+		{m.RemovedIntermediateCerts, msp.RemoveIntermediateCert},
+		{m.RemovedRootCerts, msp.RemoveRootCert},
+		{m.RemovedTLSIntermediateCerts, msp.RemoveTLSIntermediateCert},
+		{m.RemovedTLSRootCerts, msp.RemoveTLSRootCert},
+	}
+	for _, step := range steps {
+		for _, cert := range step.certs {
+			if err := step.fn(cert); err != nil {
+				return err
+			}
+		}
+	}
+	for _, crl := range m.CRLs {
+		if err := msp.AddCRL(crl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package channel
+
+import (
+	"crypto/x509"
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-config/configtx"
+	"github.com/hyperledger/fabric-config/configtx/orderer"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testCryptoDir = "../../example/testdata/crypto-config/peerOrganizations"
+
+func testPeerOrg(t *testing.T, name, mspID string) Organization {
+	dir := filepath.Join(testCryptoDir, name+".example.com")
+	rootCA, err := cryptoutil.GetCertificateFromFile(filepath.Join(dir, "ca", "ca."+name+".example.com-cert.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tlsRootCA, err := cryptoutil.GetCertificateFromFile(filepath.Join(dir, "tlsca", "tlsca."+name+".example.com-cert.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Organization{Type: protoutil.Organization_PEER, ID: mspID, Name: name, RootCA: rootCA, TLSRootCA: tlsRootCA}
+}
+
+// testChannel 返回创世区块共用的通道配置：默认的通道和排序策略以及solo排序服务
+func testChannel() configtx.Channel {
+	policies := map[string]configtx.Policy{
+		configtx.ReadersPolicyKey: {Type: configtx.ImplicitMetaPolicyType, Rule: "ANY Readers"},
+		configtx.WritersPolicyKey: {Type: configtx.ImplicitMetaPolicyType, Rule: "ANY Writers"},
+		configtx.AdminsPolicyKey:  {Type: configtx.ImplicitMetaPolicyType, Rule: "MAJORITY Admins"},
+	}
+	ordererPolicies := map[string]configtx.Policy{configtx.BlockValidationPolicyKey: policies[configtx.WritersPolicyKey]}
+	for k, v := range policies {
+		ordererPolicies[k] = v
+	}
+	return configtx.Channel{
+		Orderer: configtx.Orderer{
+			OrdererType:  orderer.ConsensusTypeSolo,
+			BatchTimeout: 2 * time.Second,
+			BatchSize:    orderer.BatchSize{MaxMessageCount: 10, AbsoluteMaxBytes: 10 * 1024 * 1024, PreferredMaxBytes: 512 * 1024},
+			Policies:     ordererPolicies,
+			State:        orderer.ConsensusStateNormal,
+		},
+		Capabilities: []string{"V2_0"},
+		Policies:     policies,
+	}
+}
+
+// testPeerOrgs 返回 org1 和 org2 两个peer组织
+func testPeerOrgs(t *testing.T) []configtx.Organization {
+	var orgs []configtx.Organization
+	for _, o := range []Organization{testPeerOrg(t, "org1", "Org1MSP"), testPeerOrg(t, "org2", "Org2MSP")} {
+		org, err := o.CreateOrganization()
+		if err != nil {
+			t.Fatal(err)
+		}
+		orgs = append(orgs, org)
+	}
+	return orgs
+}
+
+func testSystemBlock(t *testing.T) *cb.Block {
+	channel := testChannel()
+	channel.Consortiums = []configtx.Consortium{{Name: "SampleConsortium", Organizations: testPeerOrgs(t)}}
+	block, err := configtx.NewSystemChannelGenesisBlock(channel, "system-channel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return block
+}
+
+func configUpdateOf(t *testing.T, env *UpdateEnvelope) *cb.ConfigUpdate {
+	update := &cb.ConfigUpdate{}
+	if err := proto.Unmarshal(env.GetUpdates(), update); err != nil {
+		t.Fatal(err)
+	}
+	return update
+}
+
+func TestConsortiumConfigUpdate(t *testing.T) {
+	block := testSystemBlock(t)
+	caCert, err := cryptoutil.GetCertificateFromFile(filepath.Join(testCryptoDir, "org1.example.com", "ca", "ca.org1.example.com-cert.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod := ConfigModification{
+		BatchSize:    orderer.BatchSize{MaxMessageCount: 100},
+		BatchTimeout: time.Second,
+		Policies: []PolicyUpdate{{
+			Group:   ConsortiumGroup,
+			OrgName: "org1",
+			Name:    configtx.WritersPolicyKey,
+			Policy:  configtx.Policy{Type: configtx.SignaturePolicyType, Rule: "OR('Org1MSP.admin')"},
+		}},
+		Capabilities: []CapabilityUpdate{{Group: OrdererGroup, Capability: "V1_4_2"}},
+		MSPs:         []MSPUpdate{{Group: ConsortiumGroup, OrgName: "org2", AddedTLSRootCerts: []*x509.Certificate{caCert}}},
+	}
+	env, err := ConsortiumConfigUpdate(block, mod, "SampleConsortium", "system-channel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	update := configUpdateOf(t, env)
+	if update.ChannelId != "system-channel" {
+		t.Fatalf("unexpected channel %s", update.ChannelId)
+	}
+	ord := update.WriteSet.Groups[OrdererGroupKey]
+	if ord == nil || ord.Values["BatchSize"] == nil || ord.Values["BatchTimeout"] == nil || ord.Values["Capabilities"] == nil {
+		t.Fatalf("expected batch size, timeout and capabilities in the write set, got %v", ord)
+	}
+	consortium := update.WriteSet.Groups["Consortiums"].Groups["SampleConsortium"]
+	if consortium.Groups["org1"].Policies[configtx.WritersPolicyKey] == nil {
+		t.Fatal("expected the Writers policy of org1 in the write set")
+	}
+	if consortium.Groups["org2"].Values["MSP"] == nil {
+		t.Fatal("expected the MSP of org2 in the write set")
+	}
+}
+
+func TestConfigUpdateInvalidGroup(t *testing.T) {
+	block := testSystemBlock(t)
+	consortiumUpdate := func(block *cb.Block, mod ConfigModification) (*UpdateEnvelope, error) {
+		return ConsortiumConfigUpdate(block, mod, "SampleConsortium", "system-channel")
+	}
+	channelUpdate := func(block *cb.Block, mod ConfigModification) (*UpdateEnvelope, error) {
+		return ChannelConfigUpdate(block, mod, "system-channel")
+	}
+	cases := []struct {
+		name   string
+		mod    ConfigModification
+		update func(*cb.Block, ConfigModification) (*UpdateEnvelope, error)
+	}{
+		{"acls on system channel", ConfigModification{ACLs: map[string]string{"qscc/GetChainInfo": "/Channel/Application/Readers"}}, consortiumUpdate},
+		{"unknown organization", ConfigModification{Policies: []PolicyUpdate{{Group: ConsortiumGroup, OrgName: "org3", Name: "Admins"}}}, consortiumUpdate},
+		{"capability of consortium", ConfigModification{Capabilities: []CapabilityUpdate{{Group: ConsortiumGroup, Capability: "V2_0"}}}, consortiumUpdate},
+		{"consortium of app channel", ConfigModification{Policies: []PolicyUpdate{{Group: ConsortiumGroup, Name: "Admins"}}}, channelUpdate},
+	}
+	for _, c := range cases {
+		if _, err := c.update(block, c.mod); err == nil {
+			t.Errorf("%s: expected an error", c.name)
+		}
+	}
+
+	if _, err := ConsortiumConfigUpdate(block, ConfigModification{}, "SampleConsortium", "system-channel"); err == nil {
+		t.Error("expected an empty modification to be rejected")
+	}
+}
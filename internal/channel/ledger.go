@@ -0,0 +1,115 @@
+package channel
+
+import (
+	"context"
+	"github.com/godzilla-s/fabricsdk-go/internal/client/peer"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/utils"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/pkg/errors"
+	"strconv"
+	"strings"
+)
+
+// ErrTxNotFound 节点账本中没有指定的交易
+var ErrTxNotFound = errors.New("transaction not found")
+
+// queryLedger invokes the given qscc function on the peer and returns the payload
+// of the response. The ledger is read from the peer so no orderer access is required
+func queryLedger(ctx context.Context, signer cryptoutil.Signer, pClient peer.Client, fn string, args ...[]byte) ([]byte, error) {
+	invocation := &pb.ChaincodeInvocationSpec{
+		ChaincodeSpec: &pb.ChaincodeSpec{
+			Type:        pb.ChaincodeSpec_GOLANG,
+			ChaincodeId: &pb.ChaincodeID{Name: QSCC},
+			Input:       &pb.ChaincodeInput{Args: append([][]byte{[]byte(fn)}, args...)},
+		},
+	}
+
+	creator, err := signer.Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	proposal, _, err := utils.CreateProposalFromCIS(cb.HeaderType_ENDORSER_TRANSACTION, "", invocation, creator)
+	if err != nil {
+		return nil, errors.WithMessage(err, "cannot create proposal")
+	}
+
+	signedProp, err := utils.GetSignedProposal(proposal, signer)
+	if err != nil {
+		return nil, errors.WithMessage(err, "cannot create signed proposal")
+	}
+
+	payload, err := processProposal(ctx, pClient, signedProp)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "%s failed", fn)
+	}
+	return payload, nil
+}
+
+// processProposal sends the proposal to the peer and returns the payload of a
+// successful response, the failure of the peer is returned as an sdkerrors.Error
+func processProposal(ctx context.Context, pClient peer.Client, signedProp *pb.SignedProposal) ([]byte, error) {
+	endorser, err := pClient.GetEndorser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := endorser.ProcessProposal(ctx, signedProp)
+	if err != nil {
+		return nil, sdkerrors.ProposalFailed(pClient.GetAddress(), err)
+	}
+	if resp.Response == nil {
+		return nil, errors.New("received empty response")
+	}
+	if resp.Response.Status != 200 {
+		return nil, sdkerrors.EndorsementFailed(pClient.GetAddress(), resp.Response.Status, resp.Response.Message)
+	}
+	return resp.Response.Payload, nil
+}
+
+func queryBlock(ctx context.Context, signer cryptoutil.Signer, pClient peer.Client, fn string, args ...[]byte) (*cb.Block, error) {
+	payload, err := queryLedger(ctx, signer, pClient, fn, args...)
+	if err != nil {
+		return nil, err
+	}
+	block := &cb.Block{}
+	if err := proto.Unmarshal(payload, block); err != nil {
+		return nil, errors.Wrap(err, "unmarshal block")
+	}
+	return block, nil
+}
+
+// GetBlockByNumber 从节点账本中获取指定高度的区块
+func GetBlockByNumber(ctx context.Context, signer cryptoutil.Signer, pClient peer.Client, channelID string, number uint64) (*cb.Block, error) {
+	return queryBlock(ctx, signer, pClient, QSCC_GetBlockByNumber, []byte(channelID), []byte(strconv.FormatUint(number, 10)))
+}
+
+// GetBlockByHash 从节点账本中获取指定哈希的区块
+func GetBlockByHash(ctx context.Context, signer cryptoutil.Signer, pClient peer.Client, channelID string, hash []byte) (*cb.Block, error) {
+	return queryBlock(ctx, signer, pClient, QSCC_GetBlockByHash, []byte(channelID), hash)
+}
+
+// GetBlockByTxID 从节点账本中获取包含指定交易的区块
+func GetBlockByTxID(ctx context.Context, signer cryptoutil.Signer, pClient peer.Client, channelID, txID string) (*cb.Block, error) {
+	return queryBlock(ctx, signer, pClient, QSCC_GetBlockByTxID, []byte(channelID), []byte(txID))
+}
+
+// GetTransactionByID 从节点账本中获取指定交易及其验证结果，交易不存在时返回 ErrTxNotFound
+func GetTransactionByID(ctx context.Context, signer cryptoutil.Signer, pClient peer.Client, channelID, txID string) (*pb.ProcessedTransaction, error) {
+	payload, err := queryLedger(ctx, signer, pClient, QSCC_GetTransactionByTxID, []byte(channelID), []byte(txID))
+	if err != nil {
+		// qscc 只在消息中说明交易不存在
+		if e, ok := sdkerrors.As(err); ok && e.Code == sdkerrors.EndorsementFailure && strings.Contains(e.Message, "no such transaction ID") {
+			e.WithCause(ErrTxNotFound)
+		}
+		return nil, err
+	}
+	tx := &pb.ProcessedTransaction{}
+	if err := proto.Unmarshal(payload, tx); err != nil {
+		return nil, errors.Wrap(err, "unmarshal processed transaction")
+	}
+	return tx, nil
+}
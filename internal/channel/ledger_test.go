@@ -0,0 +1,51 @@
+package channel
+
+import (
+	"context"
+	"github.com/godzilla-s/fabricsdk-go/internal/client/peer"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"testing"
+)
+
+// fakePeer 由 process 处理提案的节点
+type fakePeer struct {
+	peer.Client
+	address string
+	process func(*pb.SignedProposal) (*pb.ProposalResponse, error)
+}
+
+func (p *fakePeer) GetEndorser(context.Context) (pb.EndorserClient, error) {
+	return p, nil
+}
+
+func (p *fakePeer) ProcessProposal(_ context.Context, prop *pb.SignedProposal, _ ...grpc.CallOption) (*pb.ProposalResponse, error) {
+	return p.process(prop)
+}
+
+func (p *fakePeer) GetAddress() string {
+	return p.address
+}
+
+func (p *fakePeer) Close() error {
+	return nil
+}
+
+func TestGetTransactionByIDNotFound(t *testing.T) {
+	pClient := &fakePeer{address: "peer0", process: func(*pb.SignedProposal) (*pb.ProposalResponse, error) {
+		return &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: "Failed to get transaction with id tx1, error no such transaction ID [tx1] in index"}}, nil
+	}}
+	_, err := GetTransactionByID(context.Background(), testAdminSigner(t, "org1", "Org1MSP"), pClient, "mychannel", "tx1")
+	if !errors.Is(err, ErrTxNotFound) {
+		t.Fatalf("expected ErrTxNotFound, got %v", err)
+	}
+
+	pClient.process = func(*pb.SignedProposal) (*pb.ProposalResponse, error) {
+		return &pb.ProposalResponse{Response: &pb.Response{Status: 500, Message: "access denied"}}, nil
+	}
+	_, err = GetTransactionByID(context.Background(), testAdminSigner(t, "org1", "Org1MSP"), pClient, "mychannel", "tx1")
+	if err == nil || errors.Is(err, ErrTxNotFound) {
+		t.Fatalf("expected an endorsement failure, got %v", err)
+	}
+}
@@ -33,7 +33,7 @@ func createListChannelProposal(signer cryptoutil.Signer) (*pb.Proposal, error) {
 }
 
 // List 列出节点加入的通道
-func List(signer cryptoutil.Signer, pClient peer.Client) (*pb.ChannelQueryResponse, error) {
+func List(ctx context.Context, signer cryptoutil.Signer, pClient peer.Client) (*pb.ChannelQueryResponse, error) {
 	proposal, err := createListChannelProposal(signer)
 	if err != nil {
 		return nil, err
@@ -43,20 +43,12 @@ func List(signer cryptoutil.Signer, pClient peer.Client) (*pb.ChannelQueryRespon
 	if err != nil {
 		return nil, err
 	}
-	endorser, err := pClient.GetEndorser()
+	payload, err := processProposal(ctx, pClient, signedProp)
 	if err != nil {
-		return nil, err
-	}
-	proposalResp, err := endorser.ProcessProposal(context.Background(), signedProp)
-	if err != nil {
-		return nil, fmt.Errorf("Failed sending proposal, got %s", err)
-	}
-
-	if proposalResp.Response == nil || proposalResp.Response.Status != 200 {
-		return nil, fmt.Errorf("Received bad response, status %d: %s", proposalResp.Response.Status, proposalResp.Response.Message)
+		return nil, errors.WithMessage(err, "list channels")
 	}
 	var channelQueryResponse pb.ChannelQueryResponse
-	err = proto.Unmarshal(proposalResp.Response.Payload, &channelQueryResponse)
+	err = proto.Unmarshal(payload, &channelQueryResponse)
 	if err != nil {
 		return nil, fmt.Errorf("Cannot read channels list response, %s", err)
 	}
@@ -65,53 +57,18 @@ func List(signer cryptoutil.Signer, pClient peer.Client) (*pb.ChannelQueryRespon
 }
 
 
-func createGetChannelInfoProposal(signer cryptoutil.Signer, channelID string) (*pb.Proposal, error) {
-	invocation := &pb.ChaincodeInvocationSpec{
-		ChaincodeSpec: &pb.ChaincodeSpec{
-			Type:        pb.ChaincodeSpec_Type(pb.ChaincodeSpec_Type_value["GOLANG"]),
-			ChaincodeId: &pb.ChaincodeID{Name: "qscc"},
-			Input:       &pb.ChaincodeInput{Args: [][]byte{[]byte(CSCC_GetChainInfo), []byte(channelID)}},
-		},
-	}
-
-	creator, err := signer.Serialize()
-	if err != nil {
-		return nil, err
-	}
-
-	prop, _, err := utils.CreateProposalFromCIS(cb.HeaderType_ENDORSER_TRANSACTION, "", invocation, creator)
-	if err != nil {
-		return nil, errors.WithMessage(err, "cannot create proposal")
-	}
-	return prop, nil
-}
-
 type BlockChannelInfo struct {
 	cb.BlockchainInfo
 }
 
 // GetInfo 获取通道信息
-func GetInfo(signer cryptoutil.Signer, endorser pb.EndorserClient, channelID string) (*cb.BlockchainInfo, error) {
-	proposal, err := createGetChannelInfoProposal(signer, channelID)
+func GetInfo(ctx context.Context, signer cryptoutil.Signer, pClient peer.Client, channelID string) (*cb.BlockchainInfo, error) {
+	payload, err := queryLedger(ctx, signer, pClient, CSCC_GetChainInfo, []byte(channelID))
 	if err != nil {
 		return nil, err
 	}
-
-	signedProp, err := utils.GetSignedProposal(proposal, signer)
-	if err != nil {
-		return nil, errors.WithMessage(err, "cannot create signed proposal")
-	}
-
-	resp, err := endorser.ProcessProposal(context.Background(), signedProp)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.Response == nil || resp.Response.Status != 200 {
-		return nil, errors.Errorf("received bad response, status %d: %s", resp.Response.Status, resp.Response.Message)
-	}
 	binfo := cb.BlockchainInfo{}
-	err = proto.Unmarshal(resp.Response.Payload, &binfo)
+	err = proto.Unmarshal(payload, &binfo)
 	if err != nil {
 		return nil, err
 	}
@@ -119,12 +76,12 @@ func GetInfo(signer cryptoutil.Signer, endorser pb.EndorserClient, channelID str
 }
 
 // FetchBlock 获取区块
-func FetchBlock(signer cryptoutil.Signer, oClient orderer.Client, channelID string, blockNum uint64) (*cb.Block, error) {
+func FetchBlock(ctx context.Context, signer cryptoutil.Signer, oClient orderer.Client, channelID string, blockNum uint64) (*cb.Block, error) {
 	if oClient == nil {
 		return nil, fmt.Errorf("nil orderer client")
 	}
 
-	deliverCli, err := oClient.GetDeliverClient(signer, channelID, true)
+	deliverCli, err := oClient.GetDeliverClient(ctx, signer, channelID, true)
 	if err != nil {
 		return nil, err
 	}
@@ -138,8 +95,8 @@ func FetchBlock(signer cryptoutil.Signer, oClient orderer.Client, channelID stri
 }
 
 // 获取通道创世区块（首区块）
-func FetchConfig(signer cryptoutil.Signer, ordererCli orderer.Client, channelID string) (*cb.Block, error) {
-	deliverCli, err := ordererCli.GetDeliverClient(signer, channelID, true)
+func FetchConfig(ctx context.Context, signer cryptoutil.Signer, ordererCli orderer.Client, channelID string) (*cb.Block, error) {
+	deliverCli, err := ordererCli.GetDeliverClient(ctx, signer, channelID, true)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,92 @@
+package channel
+
+import (
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	mb "github.com/hyperledger/fabric-protos-go/msp"
+	"github.com/pkg/errors"
+	"sort"
+)
+
+const (
+	channelGroupKey     = "Channel"
+	ApplicationGroupKey = "Application"
+	OrdererGroupKey     = "Orderer"
+	mspKey              = "MSP"
+)
+
+// ConfigSequence returns the sequence of the config in the config block
+func ConfigSequence(lastConfigBlock *cb.Block) (uint64, error) {
+	config, err := getBlockConfig(lastConfigBlock)
+	if err != nil {
+		return 0, err
+	}
+	return config.Sequence, nil
+}
+
+// OrganizationMSPIDs returns the sorted MSP IDs of the organizations of the
+// group (Application or Orderer) in the config block, nil if there is no such group
+func OrganizationMSPIDs(lastConfigBlock *cb.Block, groupKey string) ([]string, error) {
+	config, err := getBlockConfig(lastConfigBlock)
+	if err != nil {
+		return nil, err
+	}
+	if config.ChannelGroup == nil {
+		return nil, nil
+	}
+	group, ok := config.ChannelGroup.Groups[groupKey]
+	if !ok {
+		return nil, nil
+	}
+	var mspIDs []string
+	for orgName, org := range group.Groups {
+		mspID, err := orgMSPID(orgName, org)
+		if err != nil {
+			return nil, err
+		}
+		if mspID != "" {
+			mspIDs = append(mspIDs, mspID)
+		}
+	}
+	sort.Strings(mspIDs)
+	return mspIDs, nil
+}
+
+// OrganizationMSPID returns the MSP ID of the organization of the group in the config block
+func OrganizationMSPID(lastConfigBlock *cb.Block, groupKey, orgName string) (string, error) {
+	config, err := getBlockConfig(lastConfigBlock)
+	if err != nil {
+		return "", err
+	}
+	var org *cb.ConfigGroup
+	if config.ChannelGroup != nil && config.ChannelGroup.Groups[groupKey] != nil {
+		org = config.ChannelGroup.Groups[groupKey].Groups[orgName]
+	}
+	if org == nil {
+		return "", errors.Errorf("organization %s not exist in %s group", orgName, groupKey)
+	}
+	mspID, err := orgMSPID(orgName, org)
+	if err != nil {
+		return "", err
+	}
+	if mspID == "" {
+		return "", errors.Errorf("organization %s has no MSP", orgName)
+	}
+	return mspID, nil
+}
+
+func orgMSPID(orgName string, org *cb.ConfigGroup) (string, error) {
+	value, ok := org.Values[mspKey]
+	if !ok {
+		return "", nil
+	}
+	mspConfig := &mb.MSPConfig{}
+	if err := proto.Unmarshal(value.Value, mspConfig); err != nil {
+		return "", errors.Wrapf(err, "unmarshal MSP of organization %s", orgName)
+	}
+	fabricMSPConfig := &mb.FabricMSPConfig{}
+	if err := proto.Unmarshal(mspConfig.Config, fabricMSPConfig); err != nil {
+		return "", errors.Wrapf(err, "unmarshal MSP of organization %s", orgName)
+	}
+	return fabricMSPConfig.Name, nil
+}
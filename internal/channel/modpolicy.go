@@ -0,0 +1,335 @@
+package channel
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	mb "github.com/hyperledger/fabric-protos-go/msp"
+	"github.com/pkg/errors"
+	"sort"
+	"strings"
+)
+
+// UnsatisfiedPolicy 配置更新中未被签名满足的修改策略
+type UnsatisfiedPolicy struct {
+	// Policy 策略路径，如 /Channel/Application/Admins
+	Policy string
+	// Elements 由该策略控制的被修改配置项
+	Elements []string
+	// Missing 还需要签名的组织MSP ID
+	Missing []string
+}
+
+func (p *UnsatisfiedPolicy) String() string {
+	s := fmt.Sprintf("policy %s of %s is not satisfied", p.Policy, strings.Join(p.Elements, ", "))
+	if len(p.Missing) > 0 {
+		s += ", missing signatures from " + strings.Join(p.Missing, ", ")
+	}
+	return s
+}
+
+// configElement 配置中的组、值或者策略
+type configElement struct {
+	kind string
+	// path 所在组的路径，从Channel开始
+	path      []string
+	key       string
+	version   uint64
+	modPolicy string
+}
+
+func (e *configElement) String() string {
+	return fmt.Sprintf("[%s] /%s", e.kind, strings.Join(append(append([]string{}, e.path...), e.key), "/"))
+}
+
+// CheckConfigUpdatePolicies 检查签名者是否满足配置更新中每个被修改项的mod_policy，返回未满足的策略。
+// 与orderer一致，只检查已存在且版本增加的配置项，新增的配置项由其所在组的修改授权
+func CheckConfigUpdatePolicies(lastConfigBlock *cb.Block, update []byte, identities []*SignedIdentity) ([]*UnsatisfiedPolicy, error) {
+	config, err := getBlockConfig(lastConfigBlock)
+	if err != nil {
+		return nil, err
+	}
+	configUpdate := &cb.ConfigUpdate{}
+	if err := proto.Unmarshal(update, configUpdate); err != nil {
+		return nil, errors.Wrap(err, "unmarshal config update")
+	}
+	if configUpdate.WriteSet == nil {
+		return nil, errors.New("config update has no write set")
+	}
+
+	current := flattenConfig(config.ChannelGroup)
+	readSet := flattenConfig(configUpdate.ReadSet)
+	writeSet := flattenConfig(configUpdate.WriteSet)
+
+	ev := &policyEvaluator{root: config.ChannelGroup, identities: identities}
+	unsatisfied := make(map[string]*UnsatisfiedPolicy)
+	for key, element := range writeSet {
+		if read, ok := readSet[key]; ok && read.version == element.version {
+			continue
+		}
+		existing, ok := current[key]
+		if !ok {
+			continue
+		}
+		groupPath, name, err := modPolicyPath(existing)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "%s", existing)
+		}
+		policyPath := "/" + strings.Join(append(groupPath, name), "/")
+		if p, ok := unsatisfied[policyPath]; ok {
+			p.Elements = append(p.Elements, existing.String())
+			continue
+		}
+		if ev.evaluate(groupPath, name, make([]bool, len(identities))) {
+			continue
+		}
+		unsatisfied[policyPath] = &UnsatisfiedPolicy{
+			Policy:   policyPath,
+			Elements: []string{existing.String()},
+			Missing:  ev.missing(groupPath, name),
+		}
+	}
+
+	result := make([]*UnsatisfiedPolicy, 0, len(unsatisfied))
+	for _, p := range unsatisfied {
+		sort.Strings(p.Elements)
+		result = append(result, p)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Policy < result[j].Policy })
+	return result, nil
+}
+
+// flattenConfig 展开配置组，以配置项的类型和路径为键
+func flattenConfig(root *cb.ConfigGroup) map[string]*configElement {
+	elements := make(map[string]*configElement)
+	if root == nil {
+		return elements
+	}
+	var walk func(path []string, key string, group *cb.ConfigGroup)
+	walk = func(path []string, key string, group *cb.ConfigGroup) {
+		e := &configElement{kind: "Group", path: path, key: key, version: group.Version, modPolicy: group.ModPolicy}
+		elements[e.String()] = e
+		groupPath := append(append([]string{}, path...), key)
+		for name, value := range group.Values {
+			e := &configElement{kind: "Value", path: groupPath, key: name, version: value.Version, modPolicy: value.ModPolicy}
+			elements[e.String()] = e
+		}
+		for name, policy := range group.Policies {
+			e := &configElement{kind: "Policy", path: groupPath, key: name, version: policy.Version, modPolicy: policy.ModPolicy}
+			elements[e.String()] = e
+		}
+		for name, child := range group.Groups {
+			walk(groupPath, name, child)
+		}
+	}
+	walk(nil, channelGroupKey, root)
+	return elements
+}
+
+// modPolicyPath 解析配置项的mod_policy，相对路径相对于组自身或者值、策略所在的组
+func modPolicyPath(e *configElement) ([]string, string, error) {
+	if e.modPolicy == "" {
+		return nil, "", errors.New("mod_policy is empty")
+	}
+	var parts []string
+	if strings.HasPrefix(e.modPolicy, "/") {
+		parts = strings.Split(strings.TrimPrefix(e.modPolicy, "/"), "/")
+	} else {
+		parts = append([]string{}, e.path...)
+		if e.kind == "Group" {
+			parts = append(parts, e.key)
+		}
+		parts = append(parts, strings.Split(e.modPolicy, "/")...)
+	}
+	if len(parts) < 2 || parts[0] != channelGroupKey {
+		return nil, "", errors.Errorf("invalid mod_policy %s", e.modPolicy)
+	}
+	return parts[:len(parts)-1], parts[len(parts)-1], nil
+}
+
+// policyEvaluator 以签名者评估配置中的策略
+type policyEvaluator struct {
+	root       *cb.ConfigGroup
+	identities []*SignedIdentity
+}
+
+func (ev *policyEvaluator) group(path []string) *cb.ConfigGroup {
+	if len(path) == 0 || path[0] != channelGroupKey {
+		return nil
+	}
+	group := ev.root
+	for _, key := range path[1:] {
+		if group = group.Groups[key]; group == nil {
+			return nil
+		}
+	}
+	return group
+}
+
+func (ev *policyEvaluator) policy(path []string, name string) *cb.Policy {
+	group := ev.group(path)
+	if group == nil || group.Policies[name] == nil {
+		return nil
+	}
+	return group.Policies[name].Policy
+}
+
+// evaluate 评估组中的策略，不存在的策略不被满足
+func (ev *policyEvaluator) evaluate(path []string, name string, used []bool) bool {
+	policy := ev.policy(path, name)
+	if policy == nil {
+		return false
+	}
+	switch cb.Policy_PolicyType(policy.Type) {
+	case cb.Policy_SIGNATURE:
+		envelope := &cb.SignaturePolicyEnvelope{}
+		if err := proto.Unmarshal(policy.Value, envelope); err != nil {
+			return false
+		}
+		return ev.evaluateSignature(envelope.Rule, envelope.Identities, used)
+	case cb.Policy_IMPLICIT_META:
+		meta := &cb.ImplicitMetaPolicy{}
+		if err := proto.Unmarshal(policy.Value, meta); err != nil {
+			return false
+		}
+		children := childPaths(ev.group(path), path)
+		satisfied := 0
+		for _, child := range children {
+			if ev.evaluate(child, meta.SubPolicy, make([]bool, len(ev.identities))) {
+				satisfied++
+			}
+		}
+		return satisfied >= implicitMetaThreshold(meta.Rule, len(children))
+	}
+	return false
+}
+
+// evaluateSignature 与fabric一致，每个签名者最多满足一个 SignedBy
+func (ev *policyEvaluator) evaluateSignature(rule *cb.SignaturePolicy, principals []*mb.MSPPrincipal, used []bool) bool {
+	if rule == nil {
+		return false
+	}
+	switch t := rule.Type.(type) {
+	case *cb.SignaturePolicy_SignedBy:
+		if t.SignedBy < 0 || int(t.SignedBy) >= len(principals) {
+			return false
+		}
+		for i, id := range ev.identities {
+			if !used[i] && id.satisfies(principals[t.SignedBy]) {
+				used[i] = true
+				return true
+			}
+		}
+		return false
+	case *cb.SignaturePolicy_NOutOf_:
+		verified := 0
+		tmp := make([]bool, len(used))
+		for _, r := range t.NOutOf.Rules {
+			copy(tmp, used)
+			if ev.evaluateSignature(r, principals, tmp) {
+				verified++
+				copy(used, tmp)
+			}
+		}
+		return verified >= int(t.NOutOf.N)
+	}
+	return false
+}
+
+// missing 返回未满足的策略中还没有满足要求的签名的组织
+func (ev *policyEvaluator) missing(path []string, name string) []string {
+	mspIDs := make(map[string]bool)
+	ev.collectMissing(path, name, mspIDs)
+	result := make([]string, 0, len(mspIDs))
+	for mspID := range mspIDs {
+		result = append(result, mspID)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (ev *policyEvaluator) collectMissing(path []string, name string, mspIDs map[string]bool) {
+	policy := ev.policy(path, name)
+	if policy == nil {
+		return
+	}
+	switch cb.Policy_PolicyType(policy.Type) {
+	case cb.Policy_SIGNATURE:
+		envelope := &cb.SignaturePolicyEnvelope{}
+		if err := proto.Unmarshal(policy.Value, envelope); err != nil {
+			return
+		}
+		for _, principal := range envelope.Identities {
+			if mspID := principalMSPID(principal); mspID != "" && !ev.satisfiedBy(principal) {
+				mspIDs[mspID] = true
+			}
+		}
+	case cb.Policy_IMPLICIT_META:
+		meta := &cb.ImplicitMetaPolicy{}
+		if err := proto.Unmarshal(policy.Value, meta); err != nil {
+			return
+		}
+		for _, child := range childPaths(ev.group(path), path) {
+			if !ev.evaluate(child, meta.SubPolicy, make([]bool, len(ev.identities))) {
+				ev.collectMissing(child, meta.SubPolicy, mspIDs)
+			}
+		}
+	}
+}
+
+func (ev *policyEvaluator) satisfiedBy(principal *mb.MSPPrincipal) bool {
+	for _, id := range ev.identities {
+		if id.satisfies(principal) {
+			return true
+		}
+	}
+	return false
+}
+
+func principalMSPID(principal *mb.MSPPrincipal) string {
+	switch principal.PrincipalClassification {
+	case mb.MSPPrincipal_ROLE:
+		role := &mb.MSPRole{}
+		if proto.Unmarshal(principal.Principal, role) == nil {
+			return role.MspIdentifier
+		}
+	case mb.MSPPrincipal_IDENTITY:
+		sid := &mb.SerializedIdentity{}
+		if proto.Unmarshal(principal.Principal, sid) == nil {
+			return sid.Mspid
+		}
+	case mb.MSPPrincipal_ORGANIZATION_UNIT:
+		ou := &mb.OrganizationUnit{}
+		if proto.Unmarshal(principal.Principal, ou) == nil {
+			return ou.MspIdentifier
+		}
+	}
+	return ""
+}
+
+func childPaths(group *cb.ConfigGroup, path []string) [][]string {
+	if group == nil {
+		return nil
+	}
+	keys := make([]string, 0, len(group.Groups))
+	for key := range group.Groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	children := make([][]string, len(keys))
+	for i, key := range keys {
+		children[i] = append(append([]string{}, path...), key)
+	}
+	return children
+}
+
+func implicitMetaThreshold(rule cb.ImplicitMetaPolicy_Rule, n int) int {
+	switch rule {
+	case cb.ImplicitMetaPolicy_ANY:
+		return 1
+	case cb.ImplicitMetaPolicy_ALL:
+		return n
+	default:
+		return n/2 + 1
+	}
+}
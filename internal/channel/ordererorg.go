@@ -0,0 +1,138 @@
+package channel
+
+import (
+	"crypto/x509"
+	"fmt"
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+	"github.com/hyperledger/fabric-config/configtx"
+	"github.com/hyperledger/fabric-config/configtx/orderer"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// OrdererAddOrg 排序组织加入通道（或者系统通道）的Orderer组，组织的共识节点地址作为其排序节点地址；
+// addConsenters 为true时同时把组织的共识节点加入etcdraft集群，与orderer一致，一次最多加入一个共识节点
+func OrdererAddOrg(lastConfigBlock *cb.Block, newOrg Organization, addConsenters bool, channelID string) (*UpdateEnvelope, error) {
+	if newOrg.Type != protoutil.Organization_ORDERER {
+		return nil, errors.Errorf("organization %s is not an orderer organization", newOrg.Name)
+	}
+	config, err := getBlockConfig(lastConfigBlock)
+	if err != nil {
+		return nil, err
+	}
+	configTx := configtx.New(config)
+	ord := configTx.Orderer()
+	if ord.Organization(newOrg.Name) != nil {
+		return nil, errors.Errorf("organization %s already exists in channel %s", newOrg.Name, channelID)
+	}
+	org, err := newOrg.CreateOrganization()
+	if err != nil {
+		return nil, err
+	}
+	if err := ord.SetOrganization(org); err != nil {
+		return nil, err
+	}
+
+	if addConsenters {
+		consenters, err := etcdRaftConsenters(newOrg)
+		if err != nil {
+			return nil, err
+		}
+		if len(consenters) != 1 {
+			return nil, errors.Errorf("organization %s has %d consenters, only one consenter can be added in an update", newOrg.Name, len(consenters))
+		}
+		err = modifyEtcdRaft(ord, channelID, func(etcdRaft *orderer.EtcdRaft, state *orderer.ConsensusState) error {
+			c := consenters[0]
+			if consenterIndex(etcdRaft.Consenters, c.Address.Host, c.Address.Port) >= 0 {
+				return errors.Errorf("consenter %s:%d already exists", c.Address.Host, c.Address.Port)
+			}
+			etcdRaft.Consenters = append(etcdRaft.Consenters, c)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	update, err := configTx.ComputeMarshaledUpdate(channelID)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateEnvelope{update: update, channelID: channelID, signatures: make(map[string]*cb.ConfigSignature)}, nil
+}
+
+// OrdererRemoveOrg 从Orderer组删除排序组织；removeConsenters 为true时同时从etcdraft集群删除该组织的共识节点，
+// 共识节点的地址是组织的排序节点地址，或者其TLS证书由组织的TLS根证书签发
+func OrdererRemoveOrg(lastConfigBlock *cb.Block, orgName string, removeConsenters bool, channelID string) (*UpdateEnvelope, error) {
+	config, err := getBlockConfig(lastConfigBlock)
+	if err != nil {
+		return nil, err
+	}
+	configTx := configtx.New(config)
+	ord := configTx.Orderer()
+	ordererOrg := ord.Organization(orgName)
+	if ordererOrg == nil {
+		return nil, errors.Errorf("organization %s not exist in channel %s", orgName, channelID)
+	}
+	orgConfig, err := ordererOrg.Configuration()
+	if err != nil {
+		return nil, errors.WithMessagef(err, "config of organization %s", orgName)
+	}
+
+	if removeConsenters {
+		err = modifyEtcdRaft(ord, channelID, func(etcdRaft *orderer.EtcdRaft, state *orderer.ConsensusState) error {
+			var kept []orderer.Consenter
+			for _, c := range etcdRaft.Consenters {
+				if !orgOwnsConsenter(orgConfig, c) {
+					kept = append(kept, c)
+				}
+			}
+			switch removed := len(etcdRaft.Consenters) - len(kept); {
+			case removed > 1:
+				return errors.Errorf("organization %s has %d consenters, only one consenter can be removed in an update", orgName, removed)
+			case len(kept) == 0:
+				return errors.Errorf("the consenter of organization %s is the last one of the cluster", orgName)
+			}
+			etcdRaft.Consenters = kept
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	ord.RemoveOrganization(orgName)
+
+	update, err := configTx.ComputeMarshaledUpdate(channelID)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateEnvelope{update: update, channelID: channelID, signatures: make(map[string]*cb.ConfigSignature)}, nil
+}
+
+func orgOwnsConsenter(org configtx.Organization, c orderer.Consenter) bool {
+	address := fmt.Sprintf("%s:%d", c.Address.Host, c.Address.Port)
+	for _, endpoint := range org.OrdererEndpoints {
+		if endpoint == address {
+			return true
+		}
+	}
+	if c.ServerTLSCert == nil || len(org.MSP.TLSRootCerts) == 0 {
+		return false
+	}
+	roots := x509.NewCertPool()
+	for _, cert := range org.MSP.TLSRootCerts {
+		roots.AddCert(cert)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range org.MSP.TLSIntermediateCerts {
+		intermediates.AddCert(cert)
+	}
+	_, err := c.ServerTLSCert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   c.ServerTLSCert.NotBefore.Add(time.Second),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	return err == nil
+}
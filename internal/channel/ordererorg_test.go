@@ -0,0 +1,64 @@
+package channel
+
+import (
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	"testing"
+)
+
+func TestOrdererAddOrg(t *testing.T) {
+	block := testRaftBlock(t)
+	config, err := getBlockConfig(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 排序组织使用排序组织的策略
+	if _, ok := config.ChannelGroup.Groups[OrdererGroupKey].Groups["orderer"].Policies["Endorsement"]; ok {
+		t.Fatal("orderer organization should not have Endorsement policy")
+	}
+
+	newOrg := testPeerOrg(t, "org1", "Orderer2MSP")
+	newOrg.Name, newOrg.Type = "orderer2", protoutil.Organization_ORDERER
+	newOrg.OrdererConsenters = []Consenter{testConsenter(t, "orderer1")}
+	env, err := OrdererAddOrg(block, newOrg, true, "system-channel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	update := &cb.ConfigUpdate{}
+	if err := proto.Unmarshal(env.GetUpdates(), update); err != nil {
+		t.Fatal(err)
+	}
+	if update.WriteSet.Groups[OrdererGroupKey].Groups["orderer2"] == nil {
+		t.Fatal("orderer2 is not in the write set")
+	}
+	if _, metadata := raftConfigOf(t, env); len(metadata.Consenters) != 2 {
+		t.Fatalf("unexpected consenters %v", metadata.Consenters)
+	}
+
+	newOrg.Type = protoutil.Organization_PEER
+	if _, err := OrdererAddOrg(block, newOrg, false, "system-channel"); err == nil {
+		t.Fatal("expected error of peer organization")
+	}
+}
+
+func TestOrdererRemoveOrg(t *testing.T) {
+	block := testRaftBlock(t)
+	if _, err := OrdererRemoveOrg(block, "orderer", true, "system-channel"); err == nil {
+		t.Fatal("expected error of removing the last consenter")
+	}
+	env, err := OrdererRemoveOrg(block, "orderer", false, "system-channel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	update := &cb.ConfigUpdate{}
+	if err := proto.Unmarshal(env.GetUpdates(), update); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := update.WriteSet.Groups[OrdererGroupKey].Groups["orderer"]; ok {
+		t.Fatal("orderer is still in the write set")
+	}
+	if _, err := OrdererRemoveOrg(block, "orderer3", false, "system-channel"); err == nil {
+		t.Fatal("expected error of unknown organization")
+	}
+}
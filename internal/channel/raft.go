@@ -0,0 +1,183 @@
+package channel
+
+import (
+	"github.com/hyperledger/fabric-config/configtx"
+	"github.com/hyperledger/fabric-config/configtx/orderer"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	"github.com/pkg/errors"
+	"time"
+)
+
+// 与orderer一致，每个配置更新最多增加、删除或者替换证书一个共识节点
+
+// AddConsenter 向etcdraft集群添加共识节点，节点的地址不能已存在
+func AddConsenter(lastConfigBlock *cb.Block, consenter Consenter, channelID string) (*UpdateEnvelope, error) {
+	c, err := consenter.etcdRaftConsenter()
+	if err != nil {
+		return nil, err
+	}
+	return raftUpdate(lastConfigBlock, channelID, func(etcdRaft *orderer.EtcdRaft, state *orderer.ConsensusState) error {
+		if i := consenterIndex(etcdRaft.Consenters, consenter.Host, consenter.Port); i >= 0 {
+			return errors.Errorf("consenter %s:%d already exists", consenter.Host, consenter.Port)
+		}
+		etcdRaft.Consenters = append(etcdRaft.Consenters, c)
+		return nil
+	})
+}
+
+// RemoveConsenter 从etcdraft集群删除地址为host:port的共识节点，不能删除最后一个节点
+func RemoveConsenter(lastConfigBlock *cb.Block, host string, port int, channelID string) (*UpdateEnvelope, error) {
+	return raftUpdate(lastConfigBlock, channelID, func(etcdRaft *orderer.EtcdRaft, state *orderer.ConsensusState) error {
+		i := consenterIndex(etcdRaft.Consenters, host, port)
+		if i < 0 {
+			return errors.Errorf("consenter %s:%d not exist", host, port)
+		}
+		if len(etcdRaft.Consenters) == 1 {
+			return errors.Errorf("consenter %s:%d is the last one of the cluster", host, port)
+		}
+		etcdRaft.Consenters = append(etcdRaft.Consenters[:i], etcdRaft.Consenters[i+1:]...)
+		return nil
+	})
+}
+
+// RotateConsenterCerts 替换共识节点的服务端和客户端TLS证书，节点以地址匹配
+func RotateConsenterCerts(lastConfigBlock *cb.Block, consenter Consenter, channelID string) (*UpdateEnvelope, error) {
+	c, err := consenter.etcdRaftConsenter()
+	if err != nil {
+		return nil, err
+	}
+	return raftUpdate(lastConfigBlock, channelID, func(etcdRaft *orderer.EtcdRaft, state *orderer.ConsensusState) error {
+		i := consenterIndex(etcdRaft.Consenters, consenter.Host, consenter.Port)
+		if i < 0 {
+			return errors.Errorf("consenter %s:%d not exist", consenter.Host, consenter.Port)
+		}
+		etcdRaft.Consenters[i] = c
+		return nil
+	})
+}
+
+// UpdateRaftOptions 修改etcdraft的选项，为0或者为空的项不修改
+func UpdateRaftOptions(lastConfigBlock *cb.Block, options orderer.EtcdRaftOptions, channelID string) (*UpdateEnvelope, error) {
+	if options.TickInterval != "" {
+		interval, err := time.ParseDuration(options.TickInterval)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid tick interval %q", options.TickInterval)
+		}
+		if interval <= 0 {
+			return nil, errors.Errorf("invalid tick interval %q", options.TickInterval)
+		}
+	}
+	return raftUpdate(lastConfigBlock, channelID, func(etcdRaft *orderer.EtcdRaft, state *orderer.ConsensusState) error {
+		current := &etcdRaft.Options
+		if options.TickInterval != "" {
+			current.TickInterval = options.TickInterval
+		}
+		if options.ElectionTick != 0 {
+			current.ElectionTick = options.ElectionTick
+		}
+		if options.HeartbeatTick != 0 {
+			current.HeartbeatTick = options.HeartbeatTick
+		}
+		if options.MaxInflightBlocks != 0 {
+			current.MaxInflightBlocks = options.MaxInflightBlocks
+		}
+		if options.SnapshotIntervalSize != 0 {
+			current.SnapshotIntervalSize = options.SnapshotIntervalSize
+		}
+		if current.ElectionTick <= current.HeartbeatTick {
+			return errors.Errorf("election tick %d must be greater than heartbeat tick %d", current.ElectionTick, current.HeartbeatTick)
+		}
+		return nil
+	})
+}
+
+// SetMaintenanceMode 进入或者退出维护状态，维护状态下orderer只接受配置交易
+func SetMaintenanceMode(lastConfigBlock *cb.Block, maintenance bool, channelID string) (*UpdateEnvelope, error) {
+	return raftUpdate(lastConfigBlock, channelID, func(etcdRaft *orderer.EtcdRaft, state *orderer.ConsensusState) error {
+		if maintenance {
+			*state = orderer.ConsensusStateMaintenance
+		} else {
+			*state = orderer.ConsensusStateNormal
+		}
+		return nil
+	})
+}
+
+// raftUpdate 修改etcdraft集群的共识元数据和状态，并计算配置更新
+func raftUpdate(lastConfigBlock *cb.Block, channelID string, modify func(etcdRaft *orderer.EtcdRaft, state *orderer.ConsensusState) error) (*UpdateEnvelope, error) {
+	config, err := getBlockConfig(lastConfigBlock)
+	if err != nil {
+		return nil, err
+	}
+	configTx := configtx.New(config)
+	if err := modifyEtcdRaft(configTx.Orderer(), channelID, modify); err != nil {
+		return nil, err
+	}
+	update, err := configTx.ComputeMarshaledUpdate(channelID)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateEnvelope{update: update, channelID: channelID, signatures: make(map[string]*cb.ConfigSignature)}, nil
+}
+
+func modifyEtcdRaft(ord *configtx.OrdererGroup, channelID string, modify func(etcdRaft *orderer.EtcdRaft, state *orderer.ConsensusState) error) error {
+	cfg, err := ord.Configuration()
+	if err != nil {
+		return errors.WithMessagef(err, "orderer config of channel %s", channelID)
+	}
+	if cfg.OrdererType != orderer.ConsensusTypeEtcdRaft {
+		return errors.Errorf("consensus type of channel %s is %s, not etcdraft", channelID, cfg.OrdererType)
+	}
+
+	etcdRaft, state := cfg.EtcdRaft, cfg.State
+	if err := modify(&etcdRaft, &state); err != nil {
+		return err
+	}
+	return ord.SetEtcdRaftConsensusType(etcdRaft, state)
+}
+
+func consenterIndex(consenters []orderer.Consenter, host string, port int) int {
+	for i, c := range consenters {
+		if c.Address.Host == host && c.Address.Port == port {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c Consenter) etcdRaftConsenter() (orderer.Consenter, error) {
+	if c.Host == "" || c.Port <= 0 || c.Port > 65535 {
+		return orderer.Consenter{}, errors.Errorf("invalid consenter address %s:%d", c.Host, c.Port)
+	}
+	if len(c.ServerTLSCert.Raw) == 0 || len(c.ClientTLSCert.Raw) == 0 {
+		return orderer.Consenter{}, errors.Errorf("TLS certs of consenter %s:%d are required", c.Host, c.Port)
+	}
+	serverCert, clientCert := c.ServerTLSCert, c.ClientTLSCert
+	return orderer.Consenter{
+		Address:       orderer.EtcdAddress{Host: c.Host, Port: c.Port},
+		ServerTLSCert: &serverCert,
+		ClientTLSCert: &clientCert,
+	}, nil
+}
+
+// defaultEtcdRaftOptions fabric默认的etcdraft选项
+var defaultEtcdRaftOptions = orderer.EtcdRaftOptions{
+	TickInterval:         "500ms",
+	ElectionTick:         10,
+	HeartbeatTick:        1,
+	MaxInflightBlocks:    5,
+	SnapshotIntervalSize: 16 * 1024 * 1024,
+}
+
+// etcdRaftConsenters 排序组织的共识节点
+func etcdRaftConsenters(org Organization) ([]orderer.Consenter, error) {
+	consenters := make([]orderer.Consenter, 0, len(org.OrdererConsenters))
+	for _, c := range org.OrdererConsenters {
+		consenter, err := c.etcdRaftConsenter()
+		if err != nil {
+			return nil, errors.WithMessagef(err, "organization %s", org.Name)
+		}
+		consenters = append(consenters, consenter)
+	}
+	return consenters, nil
+}
@@ -0,0 +1,135 @@
+package channel
+
+import (
+	"github.com/godzilla-s/fabricsdk-go/gateway/protoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	ob "github.com/hyperledger/fabric-protos-go/orderer"
+	"github.com/hyperledger/fabric-protos-go/orderer/etcdraft"
+	"path/filepath"
+	"testing"
+)
+
+const testOrdererDir = "../../example/testdata/crypto-config/ordererOrganizations/example.com"
+
+func testConsenter(t *testing.T, name string) Consenter {
+	cert, err := cryptoutil.GetCertificateFromFile(filepath.Join(testOrdererDir, "orderers", name+".example.com", "tls", "server.crt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Consenter{Host: name + ".example.com", Port: 7050, ServerTLSCert: *cert, ClientTLSCert: *cert}
+}
+
+func testRaftBlock(t *testing.T) *cb.Block {
+	rootCA, err := cryptoutil.GetCertificateFromFile(filepath.Join(testOrdererDir, "ca", "ca.example.com-cert.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tlsRootCA, err := cryptoutil.GetCertificateFromFile(filepath.Join(testOrdererDir, "tlsca", "tlsca.example.com-cert.pem"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ordererOrg := Organization{
+		Type:              protoutil.Organization_ORDERER,
+		ID:                "OrdererMSP",
+		Name:              "orderer",
+		RootCA:            rootCA,
+		TLSRootCA:         tlsRootCA,
+		OrdererConsenters: []Consenter{testConsenter(t, "orderer0")},
+	}
+	peerOrgs := []Organization{testPeerOrg(t, "org1", "Org1MSP"), testPeerOrg(t, "org2", "Org2MSP")}
+	block, err := CreateSystemGenesisBlock(ordererOrg, peerOrgs, "SampleConsortium", "system-channel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return block
+}
+
+// raftConfigOf 解析配置更新写集中的共识类型
+func raftConfigOf(t *testing.T, env *UpdateEnvelope) (*ob.ConsensusType, *etcdraft.ConfigMetadata) {
+	update := &cb.ConfigUpdate{}
+	if err := proto.Unmarshal(env.GetUpdates(), update); err != nil {
+		t.Fatal(err)
+	}
+	value := update.WriteSet.Groups[OrdererGroupKey].Values["ConsensusType"]
+	if value == nil {
+		t.Fatal("consensus type is not in the write set")
+	}
+	consensusType := &ob.ConsensusType{}
+	if err := proto.Unmarshal(value.Value, consensusType); err != nil {
+		t.Fatal(err)
+	}
+	metadata := &etcdraft.ConfigMetadata{}
+	if err := proto.Unmarshal(consensusType.Metadata, metadata); err != nil {
+		t.Fatal(err)
+	}
+	return consensusType, metadata
+}
+
+func TestRaftConsenters(t *testing.T) {
+	block := testRaftBlock(t)
+
+	env, err := AddConsenter(block, testConsenter(t, "orderer1"), "system-channel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, metadata := raftConfigOf(t, env)
+	if len(metadata.Consenters) != 2 || metadata.Consenters[1].Host != "orderer1.example.com" {
+		t.Fatalf("unexpected consenters %v", metadata.Consenters)
+	}
+	if _, err := AddConsenter(block, testConsenter(t, "orderer0"), "system-channel"); err == nil {
+		t.Fatal("expected error of existing consenter")
+	}
+
+	rotated := testConsenter(t, "orderer1")
+	rotated.Host = "orderer0.example.com"
+	env, err = RotateConsenterCerts(block, rotated, "system-channel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, metadata = raftConfigOf(t, env)
+	if len(metadata.Consenters) != 1 || string(metadata.Consenters[0].ServerTlsCert) == "" {
+		t.Fatalf("unexpected consenters %v", metadata.Consenters)
+	}
+
+	if _, err := RemoveConsenter(block, "orderer0.example.com", 7050, "system-channel"); err == nil {
+		t.Fatal("expected error of removing the last consenter")
+	}
+	if _, err := RemoveConsenter(block, "orderer1.example.com", 7050, "system-channel"); err == nil {
+		t.Fatal("expected error of unknown consenter")
+	}
+}
+
+func TestRaftOptionsAndMaintenance(t *testing.T) {
+	block := testRaftBlock(t)
+
+	_, err := UpdateRaftOptions(block, defaultEtcdRaftOptions, "system-channel")
+	if err == nil {
+		t.Fatal("expected error of unchanged options")
+	}
+	options := defaultEtcdRaftOptions
+	options.SnapshotIntervalSize = 32 * 1024 * 1024
+	options.TickInterval = "1s"
+	env, err := UpdateRaftOptions(block, options, "system-channel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, metadata := raftConfigOf(t, env)
+	if metadata.Options.SnapshotIntervalSize != 32*1024*1024 || metadata.Options.TickInterval != "1s" {
+		t.Fatalf("unexpected options %v", metadata.Options)
+	}
+	options.ElectionTick = 1
+	if _, err := UpdateRaftOptions(block, options, "system-channel"); err == nil {
+		t.Fatal("expected error of election tick not greater than heartbeat tick")
+	}
+
+	env, err = SetMaintenanceMode(block, true, "system-channel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	consensusType, _ := raftConfigOf(t, env)
+	if consensusType.State != ob.ConsensusType_STATE_MAINTENANCE {
+		t.Fatalf("unexpected state %v", consensusType.State)
+	}
+}
@@ -0,0 +1,246 @@
+package channel
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	mb "github.com/hyperledger/fabric-protos-go/msp"
+	"github.com/pkg/errors"
+	"sort"
+	"time"
+)
+
+// SignedIdentity 已验证的配置更新签名者
+type SignedIdentity struct {
+	MSPID string
+	Cert  *x509.Certificate
+	// idBytes 签名头中的证书，用于匹配 IDENTITY 类型的 principal
+	idBytes []byte
+	msp     *mb.FabricMSPConfig
+}
+
+// VerifyConfigSignatures 验证配置更新的签名：签名者证书须由通道配置中其组织MSP的根证书签发且未被吊销，
+// 签名须与证书匹配。sigs 以声明的MSP ID为键，与签名头中的身份不一致时返回错误
+func VerifyConfigSignatures(lastConfigBlock *cb.Block, update []byte, sigs map[string][]byte) ([]*SignedIdentity, error) {
+	config, err := getBlockConfig(lastConfigBlock)
+	if err != nil {
+		return nil, err
+	}
+	msps, err := configMSPs(config.ChannelGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	mspIDs := make([]string, 0, len(sigs))
+	for mspID := range sigs {
+		mspIDs = append(mspIDs, mspID)
+	}
+	sort.Strings(mspIDs)
+	identities := make([]*SignedIdentity, 0, len(sigs))
+	for _, mspID := range mspIDs {
+		sig := &cb.ConfigSignature{}
+		if err := proto.Unmarshal(sigs[mspID], sig); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal signature of %s", mspID)
+		}
+		id, err := verifyConfigSignature(msps, update, sig)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, sdkerrors.PolicyFailure, "invalid signature of %s", mspID)
+		}
+		if id.MSPID != mspID {
+			return nil, sdkerrors.New(sdkerrors.PolicyFailure, "signature of %s is created by %s", mspID, id.MSPID)
+		}
+		identities = append(identities, id)
+	}
+	return identities, nil
+}
+
+func verifyConfigSignature(msps map[string]*mb.FabricMSPConfig, update []byte, sig *cb.ConfigSignature) (*SignedIdentity, error) {
+	header := &cb.SignatureHeader{}
+	if err := proto.Unmarshal(sig.SignatureHeader, header); err != nil {
+		return nil, errors.Wrap(err, "unmarshal signature header")
+	}
+	creator := &mb.SerializedIdentity{}
+	if err := proto.Unmarshal(header.Creator, creator); err != nil {
+		return nil, errors.Wrap(err, "unmarshal creator")
+	}
+	msp, ok := msps[creator.Mspid]
+	if !ok {
+		return nil, errors.Errorf("MSP %s is not in the channel config", creator.Mspid)
+	}
+	cert, err := cryptoutil.GetCertFromPEM(creator.IdBytes)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCert(cert, msp); err != nil {
+		return nil, err
+	}
+
+	pub, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.Errorf("unsupported public key type %T", cert.PublicKey)
+	}
+	digest := sha256.Sum256(concatenateBytes(sig.SignatureHeader, update))
+	valid, err := cryptoutil.VerifyECDSA(pub, sig.Signature, digest[:])
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, errors.New("signature does not match the config update")
+	}
+	return &SignedIdentity{MSPID: creator.Mspid, Cert: cert, idBytes: creator.IdBytes, msp: msp}, nil
+}
+
+// validateCert 检查证书由MSP的根证书（经由中间证书）签发且不在吊销列表中
+func validateCert(cert *x509.Certificate, msp *mb.FabricMSPConfig) error {
+	roots := x509.NewCertPool()
+	for _, root := range msp.RootCerts {
+		c, err := cryptoutil.GetCertFromPEM(root)
+		if err != nil {
+			return errors.WithMessagef(err, "root cert of MSP %s", msp.Name)
+		}
+		roots.AddCert(c)
+	}
+	intermediates := x509.NewCertPool()
+	for _, intermediate := range msp.IntermediateCerts {
+		c, err := cryptoutil.GetCertFromPEM(intermediate)
+		if err != nil {
+			return errors.WithMessagef(err, "intermediate cert of MSP %s", msp.Name)
+		}
+		intermediates.AddCert(c)
+	}
+	// 与fabric的MSP一致，以证书的生效时间验证证书链
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   cert.NotBefore.Add(time.Second),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+	if _, err := cert.Verify(opts); err != nil {
+		return errors.Wrapf(err, "certificate is not issued by MSP %s", msp.Name)
+	}
+
+	for _, crlBytes := range msp.RevocationList {
+		crl, err := x509.ParseCRL(crlBytes)
+		if err != nil {
+			return errors.Wrapf(err, "parse CRL of MSP %s", msp.Name)
+		}
+		for _, revoked := range crl.TBSCertList.RevokedCertificates {
+			if revoked.SerialNumber.Cmp(cert.SerialNumber) == 0 && crl.TBSCertList.Issuer.String() == cert.Issuer.ToRDNSequence().String() {
+				return errors.Errorf("certificate is revoked by MSP %s", msp.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// configMSPs 收集配置中所有组织的MSP，以MSP ID为键
+func configMSPs(group *cb.ConfigGroup) (map[string]*mb.FabricMSPConfig, error) {
+	msps := make(map[string]*mb.FabricMSPConfig)
+	var walk func(key string, group *cb.ConfigGroup) error
+	walk = func(key string, group *cb.ConfigGroup) error {
+		if value, ok := group.Values[mspKey]; ok {
+			mspConfig := &mb.MSPConfig{}
+			if err := proto.Unmarshal(value.Value, mspConfig); err != nil {
+				return errors.Wrapf(err, "unmarshal MSP of organization %s", key)
+			}
+			fabricMSPConfig := &mb.FabricMSPConfig{}
+			if err := proto.Unmarshal(mspConfig.Config, fabricMSPConfig); err != nil {
+				return errors.Wrapf(err, "unmarshal MSP of organization %s", key)
+			}
+			msps[fabricMSPConfig.Name] = fabricMSPConfig
+		}
+		for childKey, child := range group.Groups {
+			if err := walk(childKey, child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if group == nil {
+		return msps, nil
+	}
+	return msps, walk(channelGroupKey, group)
+}
+
+// isAdmin 证书是MSP的管理员证书，或者启用NodeOUs时带有admin OU
+func (id *SignedIdentity) isAdmin() bool {
+	for _, admin := range id.msp.Admins {
+		block, _ := pem.Decode(admin)
+		if block != nil && bytes.Equal(block.Bytes, id.Cert.Raw) {
+			return true
+		}
+	}
+	nodeOUs := id.msp.FabricNodeOus
+	return nodeOUs != nil && nodeOUs.Enable && id.hasOU(nodeOUs.AdminOuIdentifier)
+}
+
+// hasRole 启用NodeOUs时按证书OU判断 client、peer、orderer 角色
+func (id *SignedIdentity) hasRole(role mb.MSPRole_MSPRoleType) bool {
+	nodeOUs := id.msp.FabricNodeOus
+	if nodeOUs == nil || !nodeOUs.Enable {
+		return false
+	}
+	switch role {
+	case mb.MSPRole_CLIENT:
+		return id.hasOU(nodeOUs.ClientOuIdentifier)
+	case mb.MSPRole_PEER:
+		return id.hasOU(nodeOUs.PeerOuIdentifier)
+	case mb.MSPRole_ORDERER:
+		return id.hasOU(nodeOUs.OrdererOuIdentifier)
+	}
+	return false
+}
+
+func (id *SignedIdentity) hasOU(ou *mb.FabricOUIdentifier) bool {
+	if ou == nil {
+		return false
+	}
+	return id.hasOUName(ou.OrganizationalUnitIdentifier)
+}
+
+func (id *SignedIdentity) hasOUName(name string) bool {
+	for _, certOU := range id.Cert.Subject.OrganizationalUnit {
+		if certOU == name {
+			return true
+		}
+	}
+	return false
+}
+
+// satisfies 签名者是否满足 principal
+func (id *SignedIdentity) satisfies(principal *mb.MSPPrincipal) bool {
+	switch principal.PrincipalClassification {
+	case mb.MSPPrincipal_ROLE:
+		role := &mb.MSPRole{}
+		if err := proto.Unmarshal(principal.Principal, role); err != nil || role.MspIdentifier != id.MSPID {
+			return false
+		}
+		switch role.Role {
+		case mb.MSPRole_MEMBER:
+			return true
+		case mb.MSPRole_ADMIN:
+			return id.isAdmin()
+		default:
+			return id.hasRole(role.Role)
+		}
+	case mb.MSPPrincipal_IDENTITY:
+		sid := &mb.SerializedIdentity{}
+		if err := proto.Unmarshal(principal.Principal, sid); err != nil {
+			return false
+		}
+		return sid.Mspid == id.MSPID && bytes.Equal(sid.IdBytes, id.idBytes)
+	case mb.MSPPrincipal_ORGANIZATION_UNIT:
+		ou := &mb.OrganizationUnit{}
+		if err := proto.Unmarshal(principal.Principal, ou); err != nil {
+			return false
+		}
+		return ou.MspIdentifier == id.MSPID && id.hasOUName(ou.OrganizationalUnitIdentifier)
+	}
+	return false
+}
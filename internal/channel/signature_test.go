@@ -0,0 +1,142 @@
+package channel
+
+import (
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-config/configtx"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	"path/filepath"
+	"testing"
+)
+
+func testAppChannelBlock(t *testing.T) *cb.Block {
+	channel := testChannel()
+	channel.Application = configtx.Application{
+		Organizations: testPeerOrgs(t),
+		Policies:      standardApplicationChannelPoliciesV2,
+		Capabilities:  []string{"V2_0"},
+	}
+	block, err := configtx.NewApplicationChannelGenesisBlock(channel, "mychannel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return block
+}
+
+func testAdminSigner(t *testing.T, org, mspID string) cryptoutil.Signer {
+	dir := filepath.Join(testCryptoDir, org+".example.com", "users", "Admin@"+org+".example.com", "msp")
+	id, err := cryptoutil.LoadMSPDir(dir, mspID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := id.NewSigner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+func signConfig(t *testing.T, signer cryptoutil.Signer, update []byte) []byte {
+	sig, err := SignUpdateConfig(signer, update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigBytes, err := proto.Marshal(sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sigBytes
+}
+
+func TestConfigUpdatePolicies(t *testing.T) {
+	block := testAppChannelBlock(t)
+	env, err := ChannelConfigUpdate(block, ConfigModification{ACLs: map[string]string{"qscc/GetChainInfo": "/Channel/Application/Writers"}}, "mychannel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	update := env.GetUpdates()
+	org1 := signConfig(t, testAdminSigner(t, "org1", "Org1MSP"), update)
+	org2 := signConfig(t, testAdminSigner(t, "org2", "Org2MSP"), update)
+
+	identities, err := VerifyConfigSignatures(block, update, map[string][]byte{"Org1MSP": org1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsatisfied, err := CheckConfigUpdatePolicies(block, update, identities)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unsatisfied) != 1 || unsatisfied[0].Policy != "/Channel/Application/Admins" {
+		t.Fatalf("expected the Admins policy of the application to be unsatisfied, got %v", unsatisfied)
+	}
+	if missing := unsatisfied[0].Missing; len(missing) != 1 || missing[0] != "Org2MSP" {
+		t.Fatalf("expected Org2MSP to be missing, got %v", missing)
+	}
+
+	identities, err = VerifyConfigSignatures(block, update, map[string][]byte{"Org1MSP": org1, "Org2MSP": org2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unsatisfied, err = CheckConfigUpdatePolicies(block, update, identities); err != nil || len(unsatisfied) != 0 {
+		t.Fatalf("expected the policies to be satisfied, got %v %v", unsatisfied, err)
+	}
+}
+
+func TestVerifyConfigSignaturesInvalid(t *testing.T) {
+	block := testAppChannelBlock(t)
+	env, err := ChannelConfigUpdate(block, ConfigModification{ACLs: map[string]string{"qscc/GetChainInfo": "/Channel/Application/Writers"}}, "mychannel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	update := env.GetUpdates()
+	org1 := signConfig(t, testAdminSigner(t, "org1", "Org1MSP"), update)
+
+	// the creator declared by the caller must be the signer
+	if _, err := VerifyConfigSignatures(block, update, map[string][]byte{"Org2MSP": org1}); err == nil {
+		t.Fatal("expected a signature of another MSP to be rejected")
+	}
+	// the signature must be over the update
+	other := signConfig(t, testAdminSigner(t, "org1", "Org1MSP"), []byte("other update"))
+	if _, err := VerifyConfigSignatures(block, update, map[string][]byte{"Org1MSP": other}); err == nil {
+		t.Fatal("expected a signature of another update to be rejected")
+	}
+	// the signer must be issued by the MSP in the channel config
+	signer := testAdminSigner(t, "org1", "Org2MSP")
+	if _, err := VerifyConfigSignatures(block, update, map[string][]byte{"Org2MSP": signConfig(t, signer, update)}); err == nil {
+		t.Fatal("expected a certificate of another CA to be rejected")
+	}
+}
+
+func TestConfigUpdateMissingMemberSignature(t *testing.T) {
+	block := testAppChannelBlock(t)
+	env, err := ChannelConfigUpdate(block, ConfigModification{ACLs: map[string]string{"qscc/GetChainInfo": "/Channel/Application/Writers"}}, "mychannel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	update := env.GetUpdates()
+	org1 := signConfig(t, testAdminSigner(t, "org1", "Org1MSP"), update)
+	// a peer of Org2MSP is a member but not an admin
+	id, err := cryptoutil.LoadMSPDir(filepath.Join(testCryptoDir, "org2.example.com", "peers", "peer0.org2.example.com", "msp"), "Org2MSP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	member, err := id.NewSigner()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	identities, err := VerifyConfigSignatures(block, update, map[string][]byte{"Org1MSP": org1, "Org2MSP": signConfig(t, member, update)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsatisfied, err := CheckConfigUpdatePolicies(block, update, identities)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unsatisfied) != 1 || unsatisfied[0].Policy != "/Channel/Application/Admins" {
+		t.Fatalf("expected the Admins policy of the application to be unsatisfied, got %v", unsatisfied)
+	}
+	if missing := unsatisfied[0].Missing; len(missing) != 1 || missing[0] != "Org2MSP" {
+		t.Fatalf("expected Org2MSP to be missing, got %v", missing)
+	}
+}
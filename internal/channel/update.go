@@ -7,14 +7,19 @@ import (
 	"github.com/godzilla-s/fabricsdk-go/internal/client/peer"
 	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
 	"github.com/godzilla-s/fabricsdk-go/internal/utils"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
 	"github.com/golang/protobuf/proto"
 	cb "github.com/hyperledger/fabric-protos-go/common"
 	pb "github.com/hyperledger/fabric-protos-go/peer"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"sync"
 )
 
 // Create 创建通道
-func Create(signer cryptoutil.Signer, ch ChannelEnvelope, client orderer.Client) error {
+func Create(ctx context.Context, signer cryptoutil.Signer, ch ChannelEnvelope, client orderer.Client) error {
 	chEnv, err := ch.CreateEnvelope()
 	if err != nil {
 		return err
@@ -25,7 +30,7 @@ func Create(signer cryptoutil.Signer, ch ChannelEnvelope, client orderer.Client)
 		return  err
 	}
 
-	broadcastClient, err := client.GetBroadcastClient()
+	broadcastClient, err := client.GetBroadcastClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -96,7 +101,7 @@ func sanityCheckAndSignConfigTx(envConfigUpdate *cb.Envelope, signer cryptoutil.
 }
 
 // Update 更新通道
-func Update(signer cryptoutil.Signer, ch ChannelEnvelope, oClient orderer.Client) error {
+func Update(ctx context.Context, signer cryptoutil.Signer, ch ChannelEnvelope, oClient orderer.Client) error {
 	chEnv, err := ch.CreateEnvelope()
 	if err != nil {
 		return errors.WithMessage(err, "create envelope")
@@ -107,7 +112,7 @@ func Update(signer cryptoutil.Signer, ch ChannelEnvelope, oClient orderer.Client
 		return errors.WithMessage(err, "sanity check and sign configtx")
 	}
 
-	broadcast, err := oClient.GetBroadcastClient()
+	broadcast, err := oClient.GetBroadcastClient(ctx)
 	if err != nil {
 		return err
 	}
@@ -120,15 +125,29 @@ func Update(signer cryptoutil.Signer, ch ChannelEnvelope, oClient orderer.Client
 	return nil
 }
 
-func Join2(signer cryptoutil.Signer, pClient peer.Client, oClient orderer.Client, channelID string) (*pb.Response, error) {
-	ds, err := oClient.GetDeliverClient(signer, channelID, true)
+// JoinResult is the result of joining a peer to a channel
+type JoinResult struct {
+	Address string
+	Status  int32
+	Message string
+	// AlreadyJoined is set when the peer had joined the channel before, which
+	// is reported as success
+	AlreadyJoined bool
+	// Err is the error of the peer, nil if it joined the channel
+	Err error
+}
+
+// Join 将节点加入通道，所有节点并发加入，结果与 pClients 一一对应
+func Join(ctx context.Context, signer cryptoutil.Signer, pClients []peer.Client, oClient orderer.Client, channelID string) ([]*JoinResult, error) {
+	ds, err := oClient.GetDeliverClient(ctx, signer, channelID, true)
 	if err != nil {
 		return nil, err
 	}
+	defer ds.Close()
 
 	block, err := ds.GetSpecifiedBlock(0)
 	if err != nil {
-		return nil, fmt.Errorf("fail to get config block from channel %s with error: %v", channelID, err)
+		return nil, errors.WithMessagef(err, "fail to get config block from channel %s", channelID)
 	}
 
 	blockBytes, err := proto.Marshal(block)
@@ -136,60 +155,76 @@ func Join2(signer cryptoutil.Signer, pClient peer.Client, oClient orderer.Client
 		return nil, err
 	}
 
-	endorser, err := pClient.GetEndorser()
+	results := make([]*JoinResult, len(pClients))
+	var wg sync.WaitGroup
+	for i, pClient := range pClients {
+		wg.Add(1)
+		go func(i int, pClient peer.Client) {
+			defer wg.Done()
+			results[i] = joinPeer(ctx, signer, pClient, blockBytes, channelID)
+		}(i, pClient)
+	}
+	wg.Wait()
+	return results, nil
+}
+
+func joinPeer(ctx context.Context, signer cryptoutil.Signer, pClient peer.Client, blockBytes []byte, channelID string) *JoinResult {
+	result := &JoinResult{Address: pClient.GetAddress()}
+	fail := func(err error) *JoinResult {
+		result.Status = 500
+		result.Message = err.Error()
+		result.Err = err
+		return result
+	}
+
+	endorser, err := pClient.GetEndorser(ctx)
 	if err != nil {
-		return nil, err
+		return fail(err)
 	}
 	signedProp, err := createJoinChannelProposal(signer, blockBytes)
 	if err != nil {
-		return nil, err
+		return fail(err)
 	}
-
-	r, err := endorser.ProcessProposal(context.Background(), signedProp)
+	r, err := endorser.ProcessProposal(ctx, signedProp)
 	if err != nil {
-		return nil, err
+		if status.Code(err) == codes.Unavailable {
+			return fail(sdkerrors.ConnectionFailed(result.Address, err))
+		}
+		return fail(errors.WithMessage(err, "process proposal"))
 	}
-	return r.Response, nil
-}
-
-func Join(signer cryptoutil.Signer, pClients []peer.Client, oClient orderer.Client, channelID string) ([]*pb.Response, error) {
-	ds, err := oClient.GetDeliverClient(signer, channelID, true)
-	if err != nil {
-		return nil, err
+	if r.Response == nil {
+		return fail(errors.New("received proposal response with nil response"))
+	}
+	if r.Response.Status == 200 {
+		result.Status = 200
+		result.Message = r.Response.Message
+		return result
 	}
 
-	//fmt.Println("==> channel id:", channelID)
-	block, err := ds.GetSpecifiedBlock(0)
-	if err != nil {
-		return nil, fmt.Errorf("fail to get config block from channel %s with error: %v", channelID, err)
+	// the peer refuses to create the ledger again if it has joined the channel
+	if alreadyJoined(ctx, signer, pClient, channelID, r.Response.Message) {
+		result.Status = 200
+		result.AlreadyJoined = true
+		result.Message = fmt.Sprintf("peer has already joined channel %s", channelID)
+		return result
 	}
-	//fmt.Println("==> block number: ", block.Header.Number)
+	return fail(sdkerrors.EndorsementFailed(result.Address, r.Response.Status, r.Response.Message))
+}
 
-	blockBytes, err := proto.Marshal(block)
+func alreadyJoined(ctx context.Context, signer cryptoutil.Signer, pClient peer.Client, channelID, message string) bool {
+	if strings.Contains(message, "already exists") {
+		return true
+	}
+	channels, err := List(ctx, signer, pClient)
 	if err != nil {
-		return nil, err
+		return false
 	}
-
-	var responses []*pb.Response
-	for _, peerClient := range pClients {
-		endorser, err := peerClient.GetEndorser()
-		if err != nil {
-			responses = append(responses, &pb.Response{Status: 500, Message: err.Error(), Payload: []byte(peerClient.GetAddress())})
-			continue
-		}
-		signedProp, err := createJoinChannelProposal(signer, blockBytes)
-		if err != nil {
-			responses = append(responses, &pb.Response{Status: 500, Message: err.Error(), Payload: []byte(peerClient.GetAddress()) })
-			continue
-		}
-		r, err := endorser.ProcessProposal(context.Background(), signedProp)
-		if err != nil {
-			responses = append(responses, &pb.Response{Status: 500, Message: fmt.Sprintf("process proposal, error: %v", err), Payload: []byte(peerClient.GetAddress())})
-			continue
+	for _, c := range channels.Channels {
+		if c.ChannelId == channelID {
+			return true
 		}
-		responses = append(responses, r.Response)
 	}
-	return responses, nil
+	return false
 }
 
 func createJoinChannelProposal(signer cryptoutil.Signer, block []byte) (*pb.SignedProposal, error) {
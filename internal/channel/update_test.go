@@ -0,0 +1,71 @@
+package channel
+
+import (
+	"context"
+	"github.com/godzilla-s/fabricsdk-go/internal/client/orderer"
+	"github.com/godzilla-s/fabricsdk-go/internal/client/peer"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+// fakeOrderer 返回固定创世区块的排序节点
+type fakeOrderer struct {
+	orderer.Client
+	orderer.OrdererDeliverClient
+	block *cb.Block
+}
+
+func (o *fakeOrderer) GetDeliverClient(context.Context, cryptoutil.Signer, string, bool) (orderer.OrdererDeliverClient, error) {
+	return o, nil
+}
+
+func (o *fakeOrderer) GetSpecifiedBlock(uint64) (*cb.Block, error) {
+	return o.block, nil
+}
+
+func (o *fakeOrderer) Close() error {
+	return nil
+}
+
+func TestJoin(t *testing.T) {
+	respond := func(status int32, message string) func(*pb.SignedProposal) (*pb.ProposalResponse, error) {
+		return func(*pb.SignedProposal) (*pb.ProposalResponse, error) {
+			return &pb.ProposalResponse{Response: &pb.Response{Status: status, Message: message}}, nil
+		}
+	}
+	pClients := []peer.Client{
+		&fakePeer{address: "peer0", process: respond(200, "")},
+		&fakePeer{address: "peer1", process: respond(500, "cannot create ledger from genesis block: ledger [mychannel] already exists with state [ACTIVE]")},
+		&fakePeer{address: "peer2", process: func(*pb.SignedProposal) (*pb.ProposalResponse, error) {
+			return nil, status.Error(codes.Unavailable, "connection refused")
+		}},
+	}
+	oClient := &fakeOrderer{block: testAppChannelBlock(t)}
+
+	results, err := Join(context.Background(), testAdminSigner(t, "org1", "Org1MSP"), pClients, oClient, "mychannel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	for i, r := range results {
+		if r.Address != pClients[i].GetAddress() {
+			t.Fatalf("result %d is of %s, expected %s", i, r.Address, pClients[i].GetAddress())
+		}
+	}
+	if r := results[0]; r.Status != 200 || r.AlreadyJoined || r.Err != nil {
+		t.Fatalf("expected peer0 to join, got %+v", r)
+	}
+	if r := results[1]; r.Status != 200 || !r.AlreadyJoined || r.Err != nil {
+		t.Fatalf("expected peer1 to have joined already, got %+v", r)
+	}
+	if r := results[2]; r.Status != 500 || r.AlreadyJoined || !sdkerrors.Is(r.Err, sdkerrors.ConnectionFailure) {
+		t.Fatalf("expected connection failure of peer2, got %+v", r)
+	}
+}
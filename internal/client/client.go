@@ -4,3 +4,10 @@ import "github.com/godzilla-s/fabricsdk-go/internal/comm"
 
 type Option func(*comm.ClientConfig) *comm.ClientConfig
 
+// WithConnectionPool makes the client reuse connections of the pool
+func WithConnectionPool(pool *comm.ConnectionPool) Option {
+	return func(config *comm.ClientConfig) *comm.ClientConfig {
+		config.Pool = pool
+		return config
+	}
+}
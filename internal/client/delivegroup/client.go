@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
 	"github.com/godzilla-s/fabricsdk-go/internal/utils"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
 	cb "github.com/hyperledger/fabric-protos-go/common"
 	ab "github.com/hyperledger/fabric-protos-go/orderer"
 	pb "github.com/hyperledger/fabric-protos-go/peer"
@@ -85,7 +86,7 @@ func (dg *DeliverGroup) Connect(ctx context.Context) error {
 			return err
 		}
 	case <-ctx.Done():
-		err := errors.New("timed out waiting for connection to deliver on all peers")
+		err := sdkerrors.TimedOut(ctx.Err(), "timed out waiting for connection to deliver on all peers")
 		return err
 	}
 
@@ -99,7 +100,7 @@ func (dg *DeliverGroup) ClientConnect(ctx context.Context, dc *DeliverClient) {
 	defer dg.wg.Done()
 	df, err := dc.Client.DeliverFiltered(ctx)
 	if err != nil {
-		err = errors.WithMessagef(err, "error connecting to deliver filtered at %s", dc.Address)
+		err = errors.WithMessage(sdkerrors.ConnectionFailed(dc.Address, err), "error connecting to deliver filtered")
 		dg.setError(err)
 		return
 	}
@@ -109,7 +110,7 @@ func (dg *DeliverGroup) ClientConnect(ctx context.Context, dc *DeliverClient) {
 	envelope := createDeliverEnvelope(dg.ChannelID, dg.Certificate, dg.Signer)
 	err = df.Send(envelope)
 	if err != nil {
-		err = errors.WithMessagef(err, "error sending deliver seek info envelope to %s", dc.Address)
+		err = errors.WithMessage(sdkerrors.ConnectionFailed(dc.Address, err), "error sending deliver seek info envelope")
 		dg.setError(err)
 		return
 	}
@@ -135,7 +136,7 @@ func (dg *DeliverGroup) Wait(ctx context.Context) error {
 			return dg.Error
 		}
 	case <-ctx.Done():
-		err := errors.New("timed out waiting for txid on all peers")
+		err := sdkerrors.TimedOut(ctx.Err(), "timed out waiting for txid %s on all peers", dg.TxID)
 		return err
 	}
 
@@ -149,7 +150,7 @@ func (dg *DeliverGroup) ClientWait(dc *DeliverClient) {
 	for {
 		resp, err := dc.Connection.Recv()
 		if err != nil {
-			err = errors.WithMessagef(err, "error receiving from deliver filtered at %s", dc.Address)
+			err = errors.WithMessage(sdkerrors.ConnectionFailed(dc.Address, err), "error receiving from deliver filtered")
 			dg.setError(err)
 			return
 		}
@@ -160,7 +161,7 @@ func (dg *DeliverGroup) ClientWait(dc *DeliverClient) {
 				if tx.Txid == dg.TxID {
 					//logger.Infof("txid [%s] committed with status (%s) at %s", dg.TxID, tx.TxValidationCode, dc.Address)
 					if tx.TxValidationCode != pb.TxValidationCode_VALID {
-						err = errors.Errorf("transaction invalidated with status (%s)", tx.TxValidationCode)
+						err = sdkerrors.TxInvalidated(dg.TxID, dc.Address, tx.TxValidationCode)
 						dg.setError(err)
 					}
 					return
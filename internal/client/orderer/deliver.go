@@ -3,6 +3,7 @@ package orderer
 import (
 	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
 	"github.com/godzilla-s/fabricsdk-go/internal/utils"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
 	cb "github.com/hyperledger/fabric-protos-go/common"
 	ab "github.com/hyperledger/fabric-protos-go/orderer"
 	"github.com/pkg/errors"
@@ -27,6 +28,8 @@ type DeliverService struct {
 	ChannelID   string
 	TLSCertHash []byte
 	BestEffort  bool
+	// Address is the address of the orderer reported in errors
+	Address string
 }
 
 func (ds *DeliverService) seekSpecified(blockNum uint64) error {
@@ -54,12 +57,12 @@ func (ds *DeliverService) seekNewest() error {
 func (ds *DeliverService) readBlock() (*cb.Block, error) {
 	msg, err := ds.Client.Recv()
 	if err != nil {
-		return nil, errors.Wrap(err, "error receiving")
+		return nil, errors.WithMessage(sdkerrors.ConnectionFailed(ds.Address, err), "error receiving")
 	}
 
 	switch t := msg.Type.(type) {
 	case *ab.DeliverResponse_Status:
-		return nil,errors.Errorf("can't read the block: %v", t)
+		return nil, sdkerrors.OrdererRejected(ds.Address, t.Status, "can't read the block")
 	case *ab.DeliverResponse_Block:
 		if resp, err := ds.Client.Recv(); err != nil { // Flush the success message
 			// TODO
@@ -77,7 +80,7 @@ func (ds *DeliverService) readBlock() (*cb.Block, error) {
 func (ds *DeliverService) GetSpecifiedBlock(num uint64) (*cb.Block, error) {
 	err := ds.seekSpecified(num)
 	if err != nil {
-		return nil, errors.WithMessage(err, "error getting specified block")
+		return nil, errors.WithMessage(sdkerrors.ConnectionFailed(ds.Address, err), "error getting specified block")
 	}
 
 	return ds.readBlock()
@@ -87,7 +90,7 @@ func (ds *DeliverService) GetSpecifiedBlock(num uint64) (*cb.Block, error) {
 func (ds *DeliverService) GetOldestBlock() (*cb.Block, error) {
 	err := ds.seekOldest()
 	if err != nil {
-		return nil, errors.WithMessage(err, "error getting oldest block")
+		return nil, errors.WithMessage(sdkerrors.ConnectionFailed(ds.Address, err), "error getting oldest block")
 	}
 
 	return ds.readBlock()
@@ -97,7 +100,7 @@ func (ds *DeliverService) GetOldestBlock() (*cb.Block, error) {
 func (ds *DeliverService) GetNewestBlock() (*cb.Block, error) {
 	err := ds.seekNewest()
 	if err != nil {
-		return nil, errors.WithMessage(err, "error getting newest block")
+		return nil, errors.WithMessage(sdkerrors.ConnectionFailed(ds.Address, err), "error getting newest block")
 	}
 
 	return ds.readBlock()
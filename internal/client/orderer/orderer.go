@@ -6,6 +6,7 @@ import (
 	"github.com/godzilla-s/fabricsdk-go/internal/client"
 	"github.com/godzilla-s/fabricsdk-go/internal/comm"
 	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
 	cb "github.com/hyperledger/fabric-protos-go/common"
 	ab "github.com/hyperledger/fabric-protos-go/orderer"
 	"github.com/pkg/errors"
@@ -20,8 +21,9 @@ type Config struct {
 
 type Client interface {
 	GetAddress() string
-	GetBroadcastClient() (BroadcastClient, error)
-	GetDeliverClient(signer cryptoutil.Signer, channelID string, bestEffort bool) (OrdererDeliverClient, error)
+	GetBroadcastClient(ctx context.Context) (BroadcastClient, error)
+	GetDeliverClient(ctx context.Context, signer cryptoutil.Signer, channelID string, bestEffort bool) (OrdererDeliverClient, error)
+	Close() error
 }
 
 type BroadcastClient interface {
@@ -46,28 +48,36 @@ type ordererClient struct {
 	*commonClient
 }
 
-func (oc *ordererClient) Broadcast() (ab.AtomicBroadcast_BroadcastClient, error) {
-	conn, err := oc.commonClient.NewConnection(oc.address, comm.ServerNameOverride(oc.sn))
+func (oc *ordererClient) Broadcast(ctx context.Context) (ab.AtomicBroadcast_BroadcastClient, error) {
+	conn, err := oc.commonClient.NewConnection(ctx, oc.address, comm.ServerNameOverride(oc.sn))
+	if err != nil {
+		return nil, errors.WithMessage(sdkerrors.ConnectionFailed(oc.address, err), "orderer client")
+	}
+	stream, err := ab.NewAtomicBroadcastClient(conn).Broadcast(ctx)
 	if err != nil {
-		return nil, errors.WithMessagef(err, "orderer client failed to connect to %s", oc.address)
+		return nil, errors.WithMessage(sdkerrors.ConnectionFailed(oc.address, err), "orderer client")
 	}
-	return ab.NewAtomicBroadcastClient(conn).Broadcast(context.TODO())
+	return stream, nil
 }
 
-func (oc *ordererClient) Deliver() (ab.AtomicBroadcast_DeliverClient, error) {
-	conn, err := oc.commonClient.NewConnection(oc.address, comm.ServerNameOverride(oc.sn))
+func (oc *ordererClient) Deliver(ctx context.Context) (ab.AtomicBroadcast_DeliverClient, error) {
+	conn, err := oc.commonClient.NewConnection(ctx, oc.address, comm.ServerNameOverride(oc.sn))
 	if err != nil {
-		return nil, errors.WithMessagef(err, "orderer client failed to connect to %s", oc.address)
+		return nil, errors.WithMessage(sdkerrors.ConnectionFailed(oc.address, err), "orderer client")
 	}
-	return ab.NewAtomicBroadcastClient(conn).Deliver(context.TODO())
+	stream, err := ab.NewAtomicBroadcastClient(conn).Deliver(ctx)
+	if err != nil {
+		return nil, errors.WithMessage(sdkerrors.ConnectionFailed(oc.address, err), "orderer client")
+	}
+	return stream, nil
 }
 
-func (oc *ordererClient) GetBroadcastClient() (BroadcastClient, error) {
-	bc, err := oc.Broadcast()
+func (oc *ordererClient) GetBroadcastClient(ctx context.Context) (BroadcastClient, error) {
+	bc, err := oc.Broadcast(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return &broadcastClient{bc}, nil
+	return &broadcastClient{client: bc, address: oc.address}, nil
 }
 
 func (oc *ordererClient) GetAddress() string {
@@ -79,8 +89,8 @@ func (oc *ordererClient) Certificate() tls.Certificate {
 	return oc.commonClient.Certificate()
 }
 
-func (oc *ordererClient) GetDeliverClient(signer cryptoutil.Signer, channelID string, bestEffort bool) (OrdererDeliverClient, error) {
-	deliver, err := oc.Deliver()
+func (oc *ordererClient) GetDeliverClient(ctx context.Context, signer cryptoutil.Signer, channelID string, bestEffort bool) (OrdererDeliverClient, error) {
+	deliver, err := oc.Deliver(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -95,13 +105,14 @@ func (oc *ordererClient) GetDeliverClient(signer cryptoutil.Signer, channelID st
 		TLSCertHash: tlsCertHash,
 		ChannelID: channelID,
 		BestEffort: bestEffort,
+		Address: oc.address,
 	}
 	return &ds, nil
 }
 
-func New(url, serviceName string, tlsRootCert []byte) (Client, error) {
+func New(url, serviceName string, tlsRootCert []byte, opts ...client.Option) (Client, error) {
 	config := Config{Host: url, ServiceOverrideName: serviceName, RootTlsCert: tlsRootCert}
-	return config.New()
+	return config.New(opts...)
 }
 
 func (c *Config) New(opts ...client.Option) (Client, error) {
@@ -131,16 +142,17 @@ func (c *Config) New(opts ...client.Option) (Client, error) {
 
 
 type broadcastClient struct {
-	client ab.AtomicBroadcast_BroadcastClient
+	client  ab.AtomicBroadcast_BroadcastClient
+	address string
 }
 
 func (bc *broadcastClient) getAck() error {
 	msg, err := bc.client.Recv()
 	if err != nil {
-		return err
+		return errors.WithMessage(sdkerrors.ConnectionFailed(bc.address, err), "could not receive ack")
 	}
 	if msg.Status != cb.Status_SUCCESS {
-		return errors.Errorf("got unexpected status: %v -- %s", msg.Status, msg.Info)
+		return sdkerrors.OrdererRejected(bc.address, msg.Status, msg.Info)
 	}
 	return nil
 }
@@ -148,7 +160,7 @@ func (bc *broadcastClient) getAck() error {
 func (bc *broadcastClient) Send(env *cb.Envelope) error {
 	err := bc.client.Send(env)
 	if err != nil {
-		return errors.WithMessage(err, "could not send")
+		return errors.WithMessage(sdkerrors.ConnectionFailed(bc.address, err), "could not send")
 	}
 	err = bc.getAck()
 	return err
@@ -3,9 +3,9 @@ package peer
 import (
 	"context"
 	"crypto/tls"
-	"fmt"
 	"github.com/godzilla-s/fabricsdk-go/internal/client"
 	"github.com/godzilla-s/fabricsdk-go/internal/comm"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
 	cb "github.com/hyperledger/fabric-protos-go/common"
 	pb "github.com/hyperledger/fabric-protos-go/peer"
 	"github.com/pkg/errors"
@@ -13,11 +13,12 @@ import (
 )
 
 type Client interface {
-	GetDeliverService() (DeliverClient, error)
-	GetEndorser() (pb.EndorserClient, error)
-	GetDeliverClient() (pb.DeliverClient, error)
+	GetDeliverService(ctx context.Context) (DeliverClient, error)
+	GetEndorser(ctx context.Context) (pb.EndorserClient, error)
+	GetDeliverClient(ctx context.Context) (pb.DeliverClient, error)
 	GetCertificate() tls.Certificate
 	GetAddress() string
+	Close() error
 }
 
 type Config struct {
@@ -36,8 +37,7 @@ type peerDeliverService struct {
 }
 
 func (ds *peerDeliverService) Send(env *cb.Envelope) error {
-	ds.client.Send(env)
-	return nil
+	return ds.client.Send(env)
 }
 
 func (ds *peerDeliverService) Recv() (*pb.DeliverResponse, error) {
@@ -87,30 +87,33 @@ type peerClient struct {
 }
 
 
-func (pc *peerClient) GetEndorser() (pb.EndorserClient, error) {
-	conn, err := pc.commonClient.NewConnection(pc.address, comm.ServerNameOverride(pc.sn))
+func (pc *peerClient) GetEndorser(ctx context.Context) (pb.EndorserClient, error) {
+	conn, err := pc.commonClient.NewConnection(ctx, pc.address, comm.ServerNameOverride(pc.sn))
 	if err != nil {
-		return nil, errors.WithMessage(err, fmt.Sprintf("endorser client failed to connect to %s, %s", pc.address, pc.sn))
+		return nil, errors.WithMessage(sdkerrors.ConnectionFailed(pc.address, err), "endorser client")
 	}
 
 	return pb.NewEndorserClient(conn), nil
 }
 
-func (pc *peerClient) Deliver() (pb.Deliver_DeliverClient, error) {
-	conn, err := pc.commonClient.NewConnection(pc.address, comm.ServerNameOverride(pc.sn))
+func (pc *peerClient) Deliver(ctx context.Context) (pb.Deliver_DeliverClient, error) {
+	conn, err := pc.commonClient.NewConnection(ctx, pc.address, comm.ServerNameOverride(pc.sn))
 	if err != nil {
-		return nil, errors.WithMessage(err, fmt.Sprintf("endorser client failed to connect to %s", pc.address))
+		return nil, errors.WithMessage(sdkerrors.ConnectionFailed(pc.address, err), "deliver client")
 	}
-	//return pb.NewDeliverClient(conn).Deliver(context.TODO())
-	return pb.NewDeliverClient(conn).Deliver(context.Background())
+	dc, err := pb.NewDeliverClient(conn).Deliver(ctx)
+	if err != nil {
+		return nil, errors.WithMessage(sdkerrors.ConnectionFailed(pc.address, err), "deliver client")
+	}
+	return dc, nil
 }
 
 // PeerDeliver returns a client for the Deliver service for peer-specific use
 // cases (i.e. DeliverFiltered)
-func (pc *peerClient) GetDeliverClient() (pb.DeliverClient, error) {
-	conn, err := pc.commonClient.NewConnection(pc.address, comm.ServerNameOverride(pc.sn))
+func (pc *peerClient) GetDeliverClient(ctx context.Context) (pb.DeliverClient, error) {
+	conn, err := pc.commonClient.NewConnection(ctx, pc.address, comm.ServerNameOverride(pc.sn))
 	if err != nil {
-		return nil, errors.WithMessagef(err, "deliver client failed to connect to %s", pc.address)
+		return nil, errors.WithMessage(sdkerrors.ConnectionFailed(pc.address, err), "deliver client")
 	}
 	return pb.NewDeliverClient(conn), nil
 }
@@ -120,8 +123,8 @@ func (pc *peerClient) GetCertificate() tls.Certificate {
 	return pc.commonClient.Certificate()
 }
 
-func (pc *peerClient) GetDeliverService() (DeliverClient, error){
-	dc, err := pc.Deliver()
+func (pc *peerClient) GetDeliverService(ctx context.Context) (DeliverClient, error){
+	dc, err := pc.Deliver(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +133,11 @@ func (pc *peerClient) GetDeliverService() (DeliverClient, error){
 	}, nil
 }
 
+// GetAddress returns the TLS server name of the peer, or its address if the
+// server name is not set
 func (pc *peerClient) GetAddress() string {
+	if pc.sn == "" {
+		return pc.address
+	}
 	return pc.sn
 }
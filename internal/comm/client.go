@@ -9,6 +9,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 	"net"
+	"sync"
 	"time"
 )
 
@@ -96,6 +97,14 @@ type GRPCClient struct {
 	maxRecvMsgSize int
 	// Maximum message size the client can send
 	maxSendMsgSize int
+	// Pool to share connections with other clients, connections are not shared
+	// if it is nil
+	pool *ConnectionPool
+	// hash of the TLS certificates, part of the key of pooled connections
+	tlsHash []byte
+	// connections created by the client, closed or released by Close
+	mutex sync.Mutex
+	conns []*grpc.ClientConn
 }
 
 // SecureOptions defines the security parameters (e.g. TLS) for a
@@ -156,6 +165,8 @@ type ClientConfig struct {
 	Timeout time.Duration
 	// AsyncConnect makes connection creation non blocking
 	AsyncConnect bool
+	// Pool, if not nil, is used to reuse connections across clients
+	Pool *ConnectionPool
 }
 
 
@@ -188,6 +199,8 @@ func NewGRPCClient(config ClientConfig) (*GRPCClient, error) {
 	// set send/recv message size to package defaults
 	client.maxRecvMsgSize = MaxRecvMsgSize
 	client.maxSendMsgSize = MaxSendMsgSize
+	client.pool = config.Pool
+	client.tlsHash = hashTLSOptions(config.SecOpts)
 
 	return client, nil
 }
@@ -240,7 +253,33 @@ func (c *GRPCClient) parseSecureOptions(opts SecureOptions) error {
 	return nil
 }
 
-func (c *GRPCClient) NewConnection(address string, tlsOptions ...TLSOption) (*grpc.ClientConn, error) {
+// NewConnection returns a connection to the address. If the client has a
+// connection pool, a healthy pooled connection with the same TLS settings is
+// reused. The connection must not be closed by the caller, call Close on the
+// client once done with it. Dialing is aborted when ctx is done
+func (c *GRPCClient) NewConnection(ctx context.Context, address string, tlsOptions ...TLSOption) (*grpc.ClientConn, error) {
+	dial := func() (*grpc.ClientConn, error) {
+		return c.dial(ctx, address, tlsOptions...)
+	}
+
+	var conn *grpc.ClientConn
+	var err error
+	if c.pool != nil {
+		conn, err = c.pool.get(connectionKey(address, c.serverName(tlsOptions...), c.tlsHash), dial)
+	} else {
+		conn, err = dial()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	c.conns = append(c.conns, conn)
+	c.mutex.Unlock()
+	return conn, nil
+}
+
+func (c *GRPCClient) dial(ctx context.Context, address string, tlsOptions ...TLSOption) (*grpc.ClientConn, error) {
 	var dialOpts []grpc.DialOption
 	dialOpts = append(dialOpts, c.dialOpts...)
 
@@ -260,8 +299,11 @@ func (c *GRPCClient) NewConnection(address string, tlsOptions ...TLSOption) (*gr
 		grpc.MaxCallSendMsgSize(c.maxSendMsgSize),
 	))
 
-	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
-	defer cancel()
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
 	conn, err := grpc.DialContext(ctx, address, dialOpts...)
 	if err != nil {
 		return nil, errors.WithMessage(errors.WithStack(err),
@@ -270,6 +312,36 @@ func (c *GRPCClient) NewConnection(address string, tlsOptions ...TLSOption) (*gr
 	return conn, nil
 }
 
+// serverName returns the TLS server name after applying the TLS options
+func (c *GRPCClient) serverName(tlsOptions ...TLSOption) string {
+	if c.tlsConfig == nil {
+		return ""
+	}
+	tlsConfig := c.tlsConfig.Clone()
+	for _, opt := range tlsOptions {
+		opt(tlsConfig)
+	}
+	return tlsConfig.ServerName
+}
+
+// Close closes the connections created by the client, pooled connections are
+// given back to the pool instead
+func (c *GRPCClient) Close() error {
+	c.mutex.Lock()
+	conns := c.conns
+	c.conns = nil
+	c.mutex.Unlock()
+
+	for _, conn := range conns {
+		if c.pool != nil {
+			c.pool.release(conn)
+		} else {
+			conn.Close()
+		}
+	}
+	return nil
+}
+
 // Certificate returns the tls.GetCertificate used to make TLS connections
 // when client certificates are required by the server
 func (client *GRPCClient) Certificate() tls.Certificate {
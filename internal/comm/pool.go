@@ -0,0 +1,162 @@
+package comm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"sync"
+)
+
+// ConnectionPool shares grpc connections between clients that connect to the
+// same endpoint with the same TLS settings. A connection is kept open after it
+// is released so that following calls skip the dial and TLS handshake, and it
+// is evicted once it is found broken
+type ConnectionPool struct {
+	mutex sync.Mutex
+	// conns are the live connections by key
+	conns map[string]*pooledConn
+	// inUse are the connections referenced by clients, including evicted ones
+	inUse map[*grpc.ClientConn]*pooledConn
+}
+
+type pooledConn struct {
+	key     string
+	conn    *grpc.ClientConn
+	refs    int
+	evicted bool
+}
+
+// NewConnectionPool creates an empty connection pool
+func NewConnectionPool() *ConnectionPool {
+	return &ConnectionPool{
+		conns: make(map[string]*pooledConn),
+		inUse: make(map[*grpc.ClientConn]*pooledConn),
+	}
+}
+
+// connectionKey identifies a connection by address, tls server name and the
+// hash of the TLS root and client certificates
+func connectionKey(address, serverName string, tlsHash []byte) string {
+	return address + "|" + serverName + "|" + hex.EncodeToString(tlsHash)
+}
+
+func healthy(conn *grpc.ClientConn) bool {
+	switch conn.GetState() {
+	case connectivity.TransientFailure, connectivity.Shutdown:
+		return false
+	default:
+		return true
+	}
+}
+
+// get returns a healthy connection of the key, dial is called to create a new
+// one if there is none. Every successful get must be paired with a release
+func (p *ConnectionPool) get(key string, dial func() (*grpc.ClientConn, error)) (*grpc.ClientConn, error) {
+	p.mutex.Lock()
+	if conn := p.acquire(key); conn != nil {
+		p.mutex.Unlock()
+		return conn, nil
+	}
+	p.mutex.Unlock()
+
+	// dial without holding the lock, the endpoint might be slow to answer
+	conn, err := dial()
+	if err != nil {
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if pooled := p.acquire(key); pooled != nil {
+		// another caller dialed the same endpoint meanwhile
+		conn.Close()
+		return pooled, nil
+	}
+	pc := &pooledConn{key: key, conn: conn, refs: 1}
+	p.conns[key] = pc
+	p.inUse[conn] = pc
+	return conn, nil
+}
+
+// acquire references the live connection of the key if it is healthy, a broken
+// one is evicted. Must be called with the mutex held
+func (p *ConnectionPool) acquire(key string) *grpc.ClientConn {
+	pc, ok := p.conns[key]
+	if !ok {
+		return nil
+	}
+	if !healthy(pc.conn) {
+		p.evict(pc)
+		return nil
+	}
+	pc.refs++
+	p.inUse[pc.conn] = pc
+	return pc.conn
+}
+
+// release gives back a connection obtained by get
+func (p *ConnectionPool) release(conn *grpc.ClientConn) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	pc, ok := p.inUse[conn]
+	if !ok {
+		return
+	}
+	pc.refs--
+	if pc.refs > 0 {
+		return
+	}
+	delete(p.inUse, conn)
+	if pc.evicted {
+		conn.Close()
+	} else if !healthy(conn) {
+		p.evict(pc)
+	}
+}
+
+// evict removes the connection from the pool, it is closed once no client is
+// using it. Must be called with the mutex held
+func (p *ConnectionPool) evict(pc *pooledConn) {
+	if p.conns[pc.key] == pc {
+		delete(p.conns, pc.key)
+	}
+	pc.evicted = true
+	if pc.refs <= 0 {
+		delete(p.inUse, pc.conn)
+		pc.conn.Close()
+	}
+}
+
+// Len returns the number of connections in the pool
+func (p *ConnectionPool) Len() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return len(p.conns)
+}
+
+// Close empties the pool, connections still used by clients are closed when
+// they are released
+func (p *ConnectionPool) Close() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for key, pc := range p.conns {
+		delete(p.conns, key)
+		pc.evicted = true
+		if pc.refs <= 0 {
+			pc.conn.Close()
+		}
+	}
+}
+
+func hashTLSOptions(opts SecureOptions) []byte {
+	if !opts.UseTLS {
+		return nil
+	}
+	h := sha256.New()
+	for _, root := range opts.ServerRootCAs {
+		h.Write(root)
+	}
+	h.Write(opts.Certificate)
+	return h.Sum(nil)
+}
@@ -0,0 +1,92 @@
+package comm
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"net"
+	"testing"
+	"time"
+)
+
+func startServer(t *testing.T) (string, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	go srv.Serve(lis)
+	return lis.Addr().String(), srv.Stop
+}
+
+func TestConnectionPool_Reuse(t *testing.T) {
+	address, stop := startServer(t)
+	defer stop()
+
+	pool := NewConnectionPool()
+	config := ClientConfig{Timeout: time.Second, Pool: pool}
+	c1, err := NewGRPCClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c2, err := NewGRPCClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conn1, err := c1.NewConnection(context.Background(), address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn2, err := c2.NewConnection(context.Background(), address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conn1 != conn2 {
+		t.Fatal("expected the connection to be shared")
+	}
+	if pool.Len() != 1 {
+		t.Fatalf("expected 1 pooled connection, got %d", pool.Len())
+	}
+
+	c1.Close()
+	c2.Close()
+	if conn1.GetState() == connectivity.Shutdown {
+		t.Fatal("released connection should stay open in the pool")
+	}
+
+	pool.Close()
+	if conn1.GetState() != connectivity.Shutdown {
+		t.Fatal("connection should be closed with the pool")
+	}
+}
+
+func TestConnectionPool_EvictBroken(t *testing.T) {
+	address, stop := startServer(t)
+	defer stop()
+
+	pool := NewConnectionPool()
+	defer pool.Close()
+	c, err := NewGRPCClient(ClientConfig{Timeout: time.Second, Pool: pool})
+	if err != nil {
+		t.Fatal(err)
+	}
+	broken, err := c.NewConnection(context.Background(), address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Close()
+	broken.Close()
+
+	conn, err := c.NewConnection(context.Background(), address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if conn == broken {
+		t.Fatal("broken connection should not be reused")
+	}
+	if pool.Len() != 1 {
+		t.Fatalf("expected 1 pooled connection, got %d", pool.Len())
+	}
+}
@@ -138,6 +138,27 @@ func IsLowS(k *ecdsa.PublicKey, s *big.Int) (bool, error) {
 	return s.Cmp(halfOrder) != 1, nil
 }
 
+// VerifyECDSA verifies the ASN.1 signature of the digest, a signature with a
+// high S is rejected as fabric does
+func VerifyECDSA(k *ecdsa.PublicKey, signature, digest []byte) (bool, error) {
+	sig := ECDSASignature{}
+	rest, err := asn1.Unmarshal(signature, &sig)
+	if err != nil {
+		return false, fmt.Errorf("failed unmarshalling signature: %v", err)
+	}
+	if len(rest) != 0 || sig.R == nil || sig.S == nil || sig.R.Sign() != 1 || sig.S.Sign() != 1 {
+		return false, errors.New("invalid signature")
+	}
+	lowS, err := IsLowS(k, sig.S)
+	if err != nil {
+		return false, err
+	}
+	if !lowS {
+		return false, errors.New("invalid S, must be smaller than half the order")
+	}
+	return ecdsa.Verify(k, digest, sig.R, sig.S), nil
+}
+
 func ToLowS(k *ecdsa.PublicKey, s *big.Int) (*big.Int, bool, error) {
 	lowS, err := IsLowS(k, s)
 	if err != nil {
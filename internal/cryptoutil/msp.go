@@ -0,0 +1,279 @@
+package cryptoutil
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// sub directories and files of a standard fabric MSP directory
+const (
+	mspSignCerts            = "signcerts"
+	mspKeyStore             = "keystore"
+	mspCACerts              = "cacerts"
+	mspIntermediateCerts    = "intermediatecerts"
+	mspAdminCerts           = "admincerts"
+	mspTLSCACerts           = "tlscacerts"
+	mspTLSIntermediateCerts = "tlsintermediatecerts"
+	mspConfigFile           = "config.yaml"
+)
+
+// MSPConfig is the verifying material of an organization, all certificates are PEM-encoded
+type MSPConfig struct {
+	MSPID                string
+	RootCerts            [][]byte
+	IntermediateCerts    [][]byte
+	AdminCerts           [][]byte
+	TLSRootCerts         [][]byte
+	TLSIntermediateCerts [][]byte
+	NodeOUs              *NodeOUs
+}
+
+// NodeOUs is the NodeOUs section of config.yaml, the certificate of an
+// identifier is read from the file config.yaml refers to
+type NodeOUs struct {
+	Enable              bool
+	ClientOUIdentifier  *OUIdentifier
+	PeerOUIdentifier    *OUIdentifier
+	AdminOUIdentifier   *OUIdentifier
+	OrdererOUIdentifier *OUIdentifier
+}
+
+type OUIdentifier struct {
+	Certificate                  []byte
+	OrganizationalUnitIdentifier string
+}
+
+// MSPIdentity is the signing identity loaded from a MSP directory
+type MSPIdentity struct {
+	CryptoSuite
+	// PEM-encoded sign certificate and private key
+	SignCert []byte
+	Key      []byte
+	Config   *MSPConfig
+}
+
+type mspConfigYAML struct {
+	NodeOUs *struct {
+		Enable              bool                `yaml:"Enable"`
+		ClientOUIdentifier  *ouIdentifierConfig `yaml:"ClientOUIdentifier"`
+		PeerOUIdentifier    *ouIdentifierConfig `yaml:"PeerOUIdentifier"`
+		AdminOUIdentifier   *ouIdentifierConfig `yaml:"AdminOUIdentifier"`
+		OrdererOUIdentifier *ouIdentifierConfig `yaml:"OrdererOUIdentifier"`
+	} `yaml:"NodeOUs"`
+}
+
+type ouIdentifierConfig struct {
+	Certificate                  string `yaml:"Certificate"`
+	OrganizationalUnitIdentifier string `yaml:"OrganizationalUnitIdentifier"`
+}
+
+// LoadMSPDir loads the signing identity and the verifying material from a
+// standard fabric MSP directory. The private key matching the sign certificate
+// is looked up by SKI when the keystore holds several keys
+func LoadMSPDir(dir, mspID string) (*MSPIdentity, error) {
+	config, err := LoadMSPConfig(dir, mspID)
+	if err != nil {
+		return nil, err
+	}
+
+	signCerts, err := readPEMDir(filepath.Join(dir, mspSignCerts))
+	if err != nil {
+		return nil, err
+	}
+	if len(signCerts) == 0 {
+		return nil, errors.Errorf("no sign certificate found in %s", filepath.Join(dir, mspSignCerts))
+	}
+	signCert := signCerts[0]
+	cert, err := getCertFromPEM(signCert)
+	if err != nil {
+		return nil, errors.WithMessage(err, "parse sign certificate")
+	}
+	if err := verifyCert(cert, config); err != nil {
+		return nil, err
+	}
+
+	keyBytes, err := findKey(filepath.Join(dir, mspKeyStore), cert)
+	if err != nil {
+		return nil, err
+	}
+
+	cs, err := GetMyCryptoSuiteFromBytes(keyBytes, signCert, mspID)
+	if err != nil {
+		return nil, err
+	}
+	return &MSPIdentity{CryptoSuite: cs, SignCert: signCert, Key: keyBytes, Config: config}, nil
+}
+
+// LoadMSPConfig loads the verifying material of an organization from a MSP
+// directory, the directory does not need to contain a signing identity
+func LoadMSPConfig(dir, mspID string) (*MSPConfig, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, errors.Wrap(err, "invalid msp directory")
+	}
+	config := &MSPConfig{MSPID: mspID}
+
+	var err error
+	for sub, certs := range map[string]*[][]byte{
+		mspCACerts:              &config.RootCerts,
+		mspIntermediateCerts:    &config.IntermediateCerts,
+		mspAdminCerts:           &config.AdminCerts,
+		mspTLSCACerts:           &config.TLSRootCerts,
+		mspTLSIntermediateCerts: &config.TLSIntermediateCerts,
+	} {
+		*certs, err = readPEMDir(filepath.Join(dir, sub))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(config.RootCerts) == 0 {
+		return nil, errors.Errorf("no root certificate found in %s", filepath.Join(dir, mspCACerts))
+	}
+
+	config.NodeOUs, err = loadNodeOUs(dir)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func loadNodeOUs(dir string) (*NodeOUs, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, mspConfigFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "read %s", mspConfigFile)
+	}
+
+	var cf mspConfigYAML
+	if err := yaml.Unmarshal(data, &cf); err != nil {
+		return nil, errors.Wrapf(err, "parse %s", mspConfigFile)
+	}
+	if cf.NodeOUs == nil {
+		return nil, nil
+	}
+
+	nodeOUs := &NodeOUs{Enable: cf.NodeOUs.Enable}
+	for _, ou := range []struct {
+		config *ouIdentifierConfig
+		target **OUIdentifier
+	}{
+		{cf.NodeOUs.ClientOUIdentifier, &nodeOUs.ClientOUIdentifier},
+		{cf.NodeOUs.PeerOUIdentifier, &nodeOUs.PeerOUIdentifier},
+		{cf.NodeOUs.AdminOUIdentifier, &nodeOUs.AdminOUIdentifier},
+		{cf.NodeOUs.OrdererOUIdentifier, &nodeOUs.OrdererOUIdentifier},
+	} {
+		if ou.config == nil {
+			continue
+		}
+		identifier := &OUIdentifier{OrganizationalUnitIdentifier: ou.config.OrganizationalUnitIdentifier}
+		if ou.config.Certificate != "" {
+			// the certificate path is relative to the msp directory
+			identifier.Certificate, err = ioutil.ReadFile(filepath.Join(dir, ou.config.Certificate))
+			if err != nil {
+				return nil, errors.Wrapf(err, "read certificate of OU %s", ou.config.OrganizationalUnitIdentifier)
+			}
+		}
+		*ou.target = identifier
+	}
+	return nodeOUs, nil
+}
+
+// readPEMDir reads all PEM files in dir, a missing directory is not an error
+func readPEMDir(dir string) ([][]byte, error) {
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "read directory %s", dir)
+	}
+
+	var pems [][]byte
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "read file %s", f.Name())
+		}
+		if block, _ := pem.Decode(data); block == nil {
+			continue
+		}
+		pems = append(pems, data)
+	}
+	return pems, nil
+}
+
+// verifyCert checks the certificate is issued by the root certificates of the msp
+func verifyCert(cert *x509.Certificate, config *MSPConfig) error {
+	opts := x509.VerifyOptions{
+		Roots:         x509.NewCertPool(),
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		CurrentTime:   cert.NotBefore,
+	}
+	for _, root := range config.RootCerts {
+		opts.Roots.AppendCertsFromPEM(root)
+	}
+	for _, intermediate := range config.IntermediateCerts {
+		opts.Intermediates.AppendCertsFromPEM(intermediate)
+	}
+	if _, err := cert.Verify(opts); err != nil {
+		return errors.Wrap(err, "sign certificate is not issued by the msp")
+	}
+	return nil
+}
+
+// findKey returns the PEM private key in the keystore which matches the public
+// key of the certificate. Fabric names key files <hex SKI>_sk, so the file with
+// the SKI of the certificate is tried first before parsing every key
+func findKey(keystore string, cert *x509.Certificate) ([]byte, error) {
+	pub, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, errors.New("sign certificate does not hold an ecdsa public key")
+	}
+	raw := elliptic.Marshal(pub.Curve, pub.X, pub.Y)
+	hash := sha256.Sum256(raw)
+	ski := hash[:]
+
+	if data, err := ioutil.ReadFile(filepath.Join(keystore, hex.EncodeToString(ski)+"_sk")); err == nil {
+		if key, err := GetPrivateKeyFromPEM(data, nil); err == nil && bytes.Equal(key.SKI(), ski) {
+			return data, nil
+		}
+	}
+
+	files, err := ioutil.ReadDir(keystore)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read keystore %s", keystore)
+	}
+	for _, f := range files {
+		if f.IsDir() || strings.HasPrefix(f.Name(), ".") {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(keystore, f.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "read key file %s", f.Name())
+		}
+		key, err := GetPrivateKeyFromPEM(data, nil)
+		if err != nil {
+			continue
+		}
+		if bytes.Equal(key.SKI(), ski) {
+			return data, nil
+		}
+	}
+	return nil, errors.Errorf("no private key matching the sign certificate found in %s", keystore)
+}
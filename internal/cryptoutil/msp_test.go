@@ -0,0 +1,77 @@
+package cryptoutil
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const (
+	testOrgDir = "../../example/testdata/crypto-config/peerOrganizations/org1.example.com"
+	testMSPDir = testOrgDir + "/users/Admin@org1.example.com/msp"
+)
+
+func TestLoadMSPDir(t *testing.T) {
+	id, err := LoadMSPDir(testMSPDir, "Org1MSP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.GetMSPID() != "Org1MSP" {
+		t.Fatalf("unexpected msp id %s", id.GetMSPID())
+	}
+	if len(id.Config.RootCerts) != 1 || len(id.Config.TLSRootCerts) != 1 {
+		t.Fatalf("unexpected verifying material %+v", id.Config)
+	}
+	nodeOUs := id.Config.NodeOUs
+	if nodeOUs == nil || !nodeOUs.Enable || nodeOUs.AdminOUIdentifier == nil || len(nodeOUs.AdminOUIdentifier.Certificate) == 0 {
+		t.Fatalf("unexpected node OUs %+v", nodeOUs)
+	}
+	signer, err := id.NewSigner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := signer.Sign([]byte("message")); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadMSPDir_SeveralKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "msp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	copyFile := func(src, dst string) {
+		data, err := ioutil.ReadFile(src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(filepath.Dir(dst), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(dst, data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	copyFile(filepath.Join(testMSPDir, "cacerts/ca.org1.example.com-cert.pem"), filepath.Join(dir, "cacerts/ca.pem"))
+	copyFile(filepath.Join(testMSPDir, "signcerts/Admin@org1.example.com-cert.pem"), filepath.Join(dir, "signcerts/cert.pem"))
+	// the key of the ca is put in front of the key of the sign certificate
+	copyFile(filepath.Join(testOrgDir, "ca/priv_sk"), filepath.Join(dir, "keystore/a_sk"))
+	copyFile(filepath.Join(testMSPDir, "keystore/priv_sk"), filepath.Join(dir, "keystore/b_sk"))
+
+	id, err := LoadMSPDir(dir, "Org1MSP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, _ := ioutil.ReadFile(filepath.Join(testMSPDir, "keystore/priv_sk"))
+	if string(id.Key) != string(expected) {
+		t.Fatal("loaded key does not match the sign certificate")
+	}
+
+	os.Remove(filepath.Join(dir, "keystore/b_sk"))
+	if _, err := LoadMSPDir(dir, "Org1MSP"); err == nil {
+		t.Fatal("expected error when no key matches the sign certificate")
+	}
+}
@@ -0,0 +1,255 @@
+package event
+
+import (
+	"context"
+	"crypto/tls"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/utils"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	ab "github.com/hyperledger/fabric-protos-go/orderer"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/pkg/errors"
+	"math"
+	"regexp"
+)
+
+// StartType 订阅开始的位置
+type StartType int
+
+const (
+	// StartNewest 从最新的区块开始
+	StartNewest StartType = iota
+	// StartOldest 从创世区块开始，重放通道上所有的事件
+	StartOldest
+	// StartBlock 从指定高度的区块开始
+	StartBlock
+)
+
+// Start 订阅开始的区块，Type 为 StartBlock 时使用 BlockNumber
+type Start struct {
+	Type        StartType
+	BlockNumber uint64
+}
+
+func (s Start) seekPosition() *ab.SeekPosition {
+	switch s.Type {
+	case StartOldest:
+		return &ab.SeekPosition{Type: &ab.SeekPosition_Oldest{Oldest: &ab.SeekOldest{}}}
+	case StartBlock:
+		return &ab.SeekPosition{Type: &ab.SeekPosition_Specified{Specified: &ab.SeekSpecified{Number: s.BlockNumber}}}
+	default:
+		return &ab.SeekPosition{Type: &ab.SeekPosition_Newest{Newest: &ab.SeekNewest{}}}
+	}
+}
+
+// ChaincodeEventFilter 链码事件的过滤条件
+type ChaincodeEventFilter struct {
+	// ChaincodeName 链码名称，必填
+	ChaincodeName string
+	// EventPattern 事件名称的正则表达式，为空时匹配所有事件
+	EventPattern string
+	// IncludeInvalid 为true时也推送无效交易的事件
+	IncludeInvalid bool
+}
+
+// ChaincodeEvent 链码事件及其所在交易
+type ChaincodeEvent struct {
+	TxID           string
+	BlockNumber    uint64
+	ValidationCode pb.TxValidationCode
+	ChaincodeName  string
+	EventName      string
+	Payload        []byte
+}
+
+// ChaincodeEvents 从节点的Deliver服务接收区块，并解析出满足条件的链码事件
+type ChaincodeEvents struct {
+	stream  pb.Deliver_DeliverClient
+	cancel  context.CancelFunc
+	filter  ChaincodeEventFilter
+	pattern *regexp.Regexp
+	address string
+	pending []*ChaincodeEvent
+}
+
+// SubscribeChaincodeEvents 订阅通道上的链码事件，直到 ctx 结束或者调用 Close
+func SubscribeChaincodeEvents(ctx context.Context, signer cryptoutil.Signer, client pb.DeliverClient, address string, certificate tls.Certificate, channelID string, start Start, filter ChaincodeEventFilter) (*ChaincodeEvents, error) {
+	if filter.ChaincodeName == "" {
+		return nil, errors.New("chaincode name is required")
+	}
+	var pattern *regexp.Regexp
+	if filter.EventPattern != "" {
+		var err error
+		pattern, err = regexp.Compile(filter.EventPattern)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid event pattern %q", filter.EventPattern)
+		}
+	}
+
+	// 订阅持有自己的 ctx，Close 时取消，节点停止发送区块
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := client.Deliver(ctx)
+	if err != nil {
+		cancel()
+		return nil, errors.WithMessage(sdkerrors.ConnectionFailed(address, err), "error connecting to deliver")
+	}
+	env, err := seekEnvelope(channelID, signer, certificate, start.seekPosition())
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if err := stream.Send(env); err != nil {
+		cancel()
+		return nil, errors.WithMessage(sdkerrors.ConnectionFailed(address, err), "error sending deliver seek info envelope")
+	}
+	return &ChaincodeEvents{stream: stream, cancel: cancel, filter: filter, pattern: pattern, address: address}, nil
+}
+
+// Recv 返回下一个链码事件，没有事件时阻塞等待新的区块
+func (s *ChaincodeEvents) Recv() (*ChaincodeEvent, error) {
+	for len(s.pending) == 0 {
+		resp, err := s.stream.Recv()
+		if err != nil {
+			return nil, errors.WithMessage(sdkerrors.ConnectionFailed(s.address, err), "error receiving from deliver")
+		}
+		switch r := resp.Type.(type) {
+		case *pb.DeliverResponse_Block:
+			events, err := BlockChaincodeEvents(r.Block)
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range events {
+				if s.match(e) {
+					s.pending = append(s.pending, e)
+				}
+			}
+		case *pb.DeliverResponse_Status:
+			return nil, errors.Errorf("deliver completed with status (%s)", r.Status)
+		default:
+			return nil, errors.Errorf("received unexpected response type (%T) from %s", r, s.address)
+		}
+	}
+	e := s.pending[0]
+	s.pending = s.pending[1:]
+	return e, nil
+}
+
+// Close 关闭订阅，取消与节点的连接，之后 Recv 返回错误
+func (s *ChaincodeEvents) Close() error {
+	err := s.stream.CloseSend()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	return err
+}
+
+func (s *ChaincodeEvents) match(e *ChaincodeEvent) bool {
+	if e.ChaincodeName != s.filter.ChaincodeName {
+		return false
+	}
+	if e.ValidationCode != pb.TxValidationCode_VALID && !s.filter.IncludeInvalid {
+		return false
+	}
+	return s.pattern == nil || s.pattern.MatchString(e.EventName)
+}
+
+// BlockChaincodeEvents 解析区块中所有交易的链码事件，验证结果取自区块元数据。
+// 无法解析的交易（例如验证结果为 BAD_PAYLOAD 的交易）被跳过，不影响区块中其他交易的事件
+func BlockChaincodeEvents(block *cb.Block) ([]*ChaincodeEvent, error) {
+	if block.Header == nil || block.Data == nil {
+		return nil, errors.New("block has no header or data")
+	}
+	var txFilter []byte
+	if block.Metadata != nil && len(block.Metadata.Metadata) > int(cb.BlockMetadataIndex_TRANSACTIONS_FILTER) {
+		txFilter = block.Metadata.Metadata[cb.BlockMetadataIndex_TRANSACTIONS_FILTER]
+	}
+
+	var events []*ChaincodeEvent
+	for i, data := range block.Data.Data {
+		code := pb.TxValidationCode_NOT_VALIDATED
+		if i < len(txFilter) {
+			code = pb.TxValidationCode(txFilter[i])
+		}
+		txEvents, err := txChaincodeEvents(data, block.Header.Number, code)
+		if err != nil {
+			continue
+		}
+		events = append(events, txEvents...)
+	}
+	return events, nil
+}
+
+// txChaincodeEvents 解析区块中一个交易的链码事件
+func txChaincodeEvents(data []byte, blockNumber uint64, code pb.TxValidationCode) ([]*ChaincodeEvent, error) {
+	env, err := utils.GetEnvelopeFromBlock(data)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := utils.UnmarshalPayload(env.Payload)
+	if err != nil {
+		return nil, err
+	}
+	if payload.Header == nil {
+		return nil, nil
+	}
+	chdr, err := utils.UnmarshalChannelHeader(payload.Header.ChannelHeader)
+	if err != nil {
+		return nil, err
+	}
+	if cb.HeaderType(chdr.Type) != cb.HeaderType_ENDORSER_TRANSACTION {
+		return nil, nil
+	}
+
+	tx := &pb.Transaction{}
+	if err := proto.Unmarshal(payload.Data, tx); err != nil {
+		return nil, errors.Wrapf(err, "transaction %s", chdr.TxId)
+	}
+	var events []*ChaincodeEvent
+	for _, action := range tx.Actions {
+		_, ccAction, err := utils.GetPayloads(action)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "transaction %s", chdr.TxId)
+		}
+		if len(ccAction.Events) == 0 {
+			continue
+		}
+		ccEvent, err := utils.UnmarshalChaincodeEvents(ccAction.Events)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "transaction %s", chdr.TxId)
+		}
+		if ccEvent.EventName == "" {
+			continue
+		}
+		events = append(events, &ChaincodeEvent{
+			TxID:           chdr.TxId,
+			BlockNumber:    blockNumber,
+			ValidationCode: code,
+			ChaincodeName:  ccEvent.ChaincodeId,
+			EventName:      ccEvent.EventName,
+			Payload:        ccEvent.Payload,
+		})
+	}
+	return events, nil
+}
+
+// seekEnvelope 创建从 start 开始一直接收新区块的请求
+func seekEnvelope(channelID string, signer cryptoutil.Signer, certificate tls.Certificate, start *ab.SeekPosition) (*cb.Envelope, error) {
+	var tlsCertHash []byte
+	if len(certificate.Certificate) > 0 {
+		tlsCertHash = cryptoutil.ComputeSHA256(certificate.Certificate[0])
+	}
+	seekInfo := &ab.SeekInfo{
+		Start: start,
+		Stop: &ab.SeekPosition{
+			Type: &ab.SeekPosition_Specified{Specified: &ab.SeekSpecified{Number: math.MaxUint64}},
+		},
+		Behavior: ab.SeekInfo_BLOCK_UNTIL_READY,
+	}
+	env, err := utils.CreateSignedEnvelopeWithTLSBinding(cb.HeaderType_DELIVER_SEEK_INFO, channelID, signer, seekInfo, 0, 0, tlsCertHash)
+	if err != nil {
+		return nil, errors.WithMessage(err, "create deliver seek info envelope")
+	}
+	return env, nil
+}
@@ -0,0 +1,182 @@
+package event
+
+import (
+	"context"
+	"crypto/tls"
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"google.golang.org/grpc"
+	"io"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func testTx(t *testing.T, txID string, event *pb.ChaincodeEvent) []byte {
+	marshal := func(m proto.Message) []byte {
+		data, err := proto.Marshal(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	action := &pb.ChaincodeAction{Response: &pb.Response{Status: 200}}
+	if event != nil {
+		action.Events = marshal(event)
+	}
+	tx := &pb.Transaction{Actions: []*pb.TransactionAction{{
+		Payload: marshal(&pb.ChaincodeActionPayload{
+			Action: &pb.ChaincodeEndorsedAction{
+				ProposalResponsePayload: marshal(&pb.ProposalResponsePayload{Extension: marshal(action)}),
+			},
+		}),
+	}}}
+	payload := &cb.Payload{
+		Header: &cb.Header{ChannelHeader: marshal(&cb.ChannelHeader{Type: int32(cb.HeaderType_ENDORSER_TRANSACTION), TxId: txID})},
+		Data:   marshal(tx),
+	}
+	return marshal(&cb.Envelope{Payload: marshal(payload)})
+}
+
+func testBlock(t *testing.T, number uint64) *cb.Block {
+	metadata := make([][]byte, int(cb.BlockMetadataIndex_TRANSACTIONS_FILTER)+1)
+	metadata[cb.BlockMetadataIndex_TRANSACTIONS_FILTER] = []byte{
+		byte(pb.TxValidationCode_VALID),
+		byte(pb.TxValidationCode_MVCC_READ_CONFLICT),
+		byte(pb.TxValidationCode_VALID),
+		byte(pb.TxValidationCode_VALID),
+	}
+	return &cb.Block{
+		Header: &cb.BlockHeader{Number: number},
+		Data: &cb.BlockData{Data: [][]byte{
+			testTx(t, "tx1", &pb.ChaincodeEvent{ChaincodeId: "sacc", TxId: "tx1", EventName: "transfer", Payload: []byte("1")}),
+			testTx(t, "tx2", &pb.ChaincodeEvent{ChaincodeId: "sacc", TxId: "tx2", EventName: "transfer", Payload: []byte("2")}),
+			testTx(t, "tx3", &pb.ChaincodeEvent{ChaincodeId: "other", TxId: "tx3", EventName: "transfer"}),
+			testTx(t, "tx4", nil),
+		}},
+		Metadata: &cb.BlockMetadata{Metadata: metadata},
+	}
+}
+
+func TestBlockChaincodeEvents(t *testing.T) {
+	events, err := BlockChaincodeEvents(testBlock(t, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d", len(events))
+	}
+	e := events[1]
+	if e.TxID != "tx2" || e.BlockNumber != 5 || e.ValidationCode != pb.TxValidationCode_MVCC_READ_CONFLICT || string(e.Payload) != "2" {
+		t.Fatalf("unexpected event %+v", e)
+	}
+}
+
+func TestBlockChaincodeEventsSkipsBadTx(t *testing.T) {
+	block := testBlock(t, 5)
+	block.Data.Data[0] = []byte("not a transaction")
+	events, err := BlockChaincodeEvents(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].TxID != "tx2" || events[1].TxID != "tx3" {
+		t.Fatalf("expected the events of tx2 and tx3, got %+v", events)
+	}
+}
+
+// deliverStream 依次返回给定的区块
+type deliverStream struct {
+	grpc.ClientStream
+	blocks []*cb.Block
+}
+
+func (s *deliverStream) Send(*cb.Envelope) error { return nil }
+
+func (s *deliverStream) CloseSend() error { return nil }
+
+func (s *deliverStream) Recv() (*pb.DeliverResponse, error) {
+	if len(s.blocks) == 0 {
+		return nil, io.EOF
+	}
+	block := s.blocks[0]
+	s.blocks = s.blocks[1:]
+	return &pb.DeliverResponse{Type: &pb.DeliverResponse_Block{Block: block}}, nil
+}
+
+func recvAll(s *ChaincodeEvents) []*ChaincodeEvent {
+	var events []*ChaincodeEvent
+	for {
+		e, err := s.Recv()
+		if err != nil {
+			return events
+		}
+		events = append(events, e)
+	}
+}
+
+func TestChaincodeEventsFilter(t *testing.T) {
+	blocks := func() *deliverStream {
+		return &deliverStream{blocks: []*cb.Block{testBlock(t, 1), testBlock(t, 2)}}
+	}
+
+	s := &ChaincodeEvents{stream: blocks(), filter: ChaincodeEventFilter{ChaincodeName: "sacc"}}
+	events := recvAll(s)
+	if len(events) != 2 || events[0].TxID != "tx1" || events[0].BlockNumber != 1 || events[1].BlockNumber != 2 {
+		t.Fatalf("expected valid events of sacc, got %v", events)
+	}
+
+	s = &ChaincodeEvents{stream: blocks(), filter: ChaincodeEventFilter{ChaincodeName: "sacc", IncludeInvalid: true}}
+	if events := recvAll(s); len(events) != 4 {
+		t.Fatalf("expected 4 events, got %d", len(events))
+	}
+
+	s = &ChaincodeEvents{stream: blocks(), filter: ChaincodeEventFilter{ChaincodeName: "sacc"}, pattern: regexp.MustCompile("^mint$")}
+	if events := recvAll(s); len(events) != 0 {
+		t.Fatalf("expected no events, got %d", len(events))
+	}
+}
+
+// blockingDeliver 不发送区块，Recv 阻塞直到连接的 ctx 结束
+type blockingDeliver struct {
+	pb.DeliverClient
+}
+
+func (d *blockingDeliver) Deliver(ctx context.Context, _ ...grpc.CallOption) (pb.Deliver_DeliverClient, error) {
+	return &blockingStream{ctx: ctx}, nil
+}
+
+type blockingStream struct {
+	grpc.ClientStream
+	ctx context.Context
+}
+
+func (s *blockingStream) Send(*cb.Envelope) error { return nil }
+
+func (s *blockingStream) CloseSend() error { return nil }
+
+func (s *blockingStream) Recv() (*pb.DeliverResponse, error) {
+	<-s.ctx.Done()
+	return nil, s.ctx.Err()
+}
+
+func TestChaincodeEventsClose(t *testing.T) {
+	events, err := SubscribeChaincodeEvents(context.Background(), testSigner(t), &blockingDeliver{}, "peer0", tls.Certificate{}, "mychannel", Start{}, ChaincodeEventFilter{ChaincodeName: "sacc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := events.Recv()
+		errCh <- err
+	}()
+	events.Close()
+	select {
+	case err := <-errCh:
+		if err == nil {
+			t.Fatal("expected error after close")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected Recv to return after close")
+	}
+}
@@ -0,0 +1,339 @@
+package event
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/godzilla-s/fabricsdk-go/sdkerrors"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/ledger/rwset"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMinBackoff = 500 * time.Millisecond
+	defaultMaxBackoff = 30 * time.Second
+)
+
+// BlockType 监听的区块类型
+type BlockType int
+
+const (
+	// FullBlock 完整的区块，对应 Deliver
+	FullBlock BlockType = iota
+	// FilteredBlock 只包含交易ID、验证结果和链码事件名称的区块，对应 DeliverFiltered
+	FilteredBlock
+	// PrivateDataBlock 完整的区块及签名者有权访问的私有数据，对应 DeliverWithPrivateData
+	PrivateDataBlock
+)
+
+func (t BlockType) String() string {
+	switch t {
+	case FullBlock:
+		return "full"
+	case FilteredBlock:
+		return "filtered"
+	case PrivateDataBlock:
+		return "pvtdata"
+	}
+	return fmt.Sprintf("BlockType(%d)", int(t))
+}
+
+// Block 监听到的区块，FilteredBlock 类型时只设置 FilteredBlock，其他类型设置 Block
+type Block struct {
+	Number uint64
+	// Peer 区块来自的节点
+	Peer          string
+	Block         *cb.Block
+	FilteredBlock *pb.FilteredBlock
+	// PrivateData 私有数据，按交易在区块中的序号索引
+	PrivateData map[uint64]*rwset.TxPvtReadWriteSet
+}
+
+// Checkpointer 记录已处理的区块，监听器重启或者重连时从下一个区块继续
+type Checkpointer interface {
+	// Load 返回下一个要处理的区块高度，ok 为false时还没有检查点
+	Load() (next uint64, ok bool, err error)
+	// Save 记录 next 之前的区块都已处理
+	Save(next uint64) error
+}
+
+// FileCheckpointer 将检查点保存在文件中
+type FileCheckpointer struct {
+	path string
+}
+
+type fileCheckpoint struct {
+	NextBlock uint64 `json:"next_block"`
+}
+
+// NewFileCheckpointer 创建保存在 path 的检查点，文件不存在时视为没有检查点
+func NewFileCheckpointer(path string) *FileCheckpointer {
+	return &FileCheckpointer{path: path}
+}
+
+func (c *FileCheckpointer) Load() (uint64, bool, error) {
+	data, err := ioutil.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, errors.Wrap(err, "read checkpoint")
+	}
+	checkpoint := &fileCheckpoint{}
+	if err := json.Unmarshal(data, checkpoint); err != nil {
+		return 0, false, errors.Wrapf(err, "invalid checkpoint file %s", c.path)
+	}
+	return checkpoint.NextBlock, true, nil
+}
+
+// Save 先写临时文件再重命名，进程中断时不会留下不完整的检查点
+func (c *FileCheckpointer) Save(next uint64) error {
+	data, err := json.Marshal(&fileCheckpoint{NextBlock: next})
+	if err != nil {
+		return errors.Wrap(err, "marshal checkpoint")
+	}
+	tmp := c.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return errors.Wrap(err, "write checkpoint")
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return errors.Wrap(err, "write checkpoint")
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return errors.Wrap(err, "write checkpoint")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "write checkpoint")
+	}
+	return errors.Wrap(os.Rename(tmp, c.path), "write checkpoint")
+}
+
+// MemoryCheckpointer 只在内存中记录检查点，监听器重启后从 ListenerConfig.Start 开始
+type MemoryCheckpointer struct {
+	mutex sync.Mutex
+	next  uint64
+	ok    bool
+}
+
+// NewMemoryCheckpointer 创建内存中的检查点，用于不需要在重启后继续的监听器
+func NewMemoryCheckpointer() *MemoryCheckpointer {
+	return &MemoryCheckpointer{}
+}
+
+func (c *MemoryCheckpointer) Load() (uint64, bool, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.next, c.ok, nil
+}
+
+func (c *MemoryCheckpointer) Save(next uint64) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.next, c.ok = next, true
+	return nil
+}
+
+// DeliverPeer 提供区块的节点，peer.Client 实现了该接口
+type DeliverPeer interface {
+	GetDeliverClient(ctx context.Context) (pb.DeliverClient, error)
+	GetCertificate() tls.Certificate
+	GetAddress() string
+}
+
+// ListenerConfig 区块监听器的配置
+type ListenerConfig struct {
+	ChannelID string
+	Type      BlockType
+	// Start 没有检查点时开始的区块
+	Start Start
+	// Checkpointer 为nil时使用 CheckpointDir 下按通道和区块类型命名的 FileCheckpointer，
+	// 不需要在重启后继续时使用 MemoryCheckpointer
+	Checkpointer Checkpointer
+	// CheckpointDir 默认检查点文件所在的目录，为空时为当前目录
+	CheckpointDir string
+	// MinBackoff 和 MaxBackoff 是重连前的等待时间，每次失败后加倍，默认为500ms和30s
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// OnError 与节点的连接断开时调用，可用于记录日志
+	OnError func(peer string, err error)
+}
+
+// BlockListener 持续接收通道上的区块，连接断开时按退避时间重连，并在节点之间切换
+type BlockListener struct {
+	signer  cryptoutil.Signer
+	peers   []DeliverPeer
+	config  ListenerConfig
+	current int
+}
+
+// blockStream 三种 Deliver 流的共同接口
+type blockStream interface {
+	Send(*cb.Envelope) error
+	Recv() (*pb.DeliverResponse, error)
+}
+
+// stopError 不能通过重连恢复的错误
+type stopError struct {
+	err error
+}
+
+func (e *stopError) Error() string {
+	return e.err.Error()
+}
+
+// NewBlockListener 创建区块监听器，peers 一般是同一组织的节点
+func NewBlockListener(signer cryptoutil.Signer, peers []DeliverPeer, config ListenerConfig) (*BlockListener, error) {
+	if len(peers) == 0 {
+		return nil, errors.New("at least one peer is required")
+	}
+	if config.ChannelID == "" {
+		return nil, errors.New("channel id is required")
+	}
+	if config.Type < FullBlock || config.Type > PrivateDataBlock {
+		return nil, errors.Errorf("unsupported block type %d", config.Type)
+	}
+	if config.MinBackoff <= 0 {
+		config.MinBackoff = defaultMinBackoff
+	}
+	if config.MaxBackoff < config.MinBackoff {
+		config.MaxBackoff = defaultMaxBackoff
+		if config.MaxBackoff < config.MinBackoff {
+			config.MaxBackoff = config.MinBackoff
+		}
+	}
+	if config.Checkpointer == nil {
+		name := fmt.Sprintf("%s-%s.json", config.ChannelID, config.Type)
+		config.Checkpointer = NewFileCheckpointer(filepath.Join(config.CheckpointDir, name))
+	}
+	return &BlockListener{signer: signer, peers: peers, config: config}, nil
+}
+
+// Run 依次把区块交给 handle，handle 返回nil后保存检查点。
+// 直到 ctx 结束、handle 返回错误或者节点拒绝请求时返回
+func (l *BlockListener) Run(ctx context.Context, handle func(*Block) error) error {
+	start := l.config.Start
+	next, ok, err := l.config.Checkpointer.Load()
+	if err != nil {
+		return errors.WithMessage(err, "load checkpoint")
+	}
+	if ok {
+		start = Start{Type: StartBlock, BlockNumber: next}
+	}
+
+	backoff := l.config.MinBackoff
+	for {
+		peer := l.peers[l.current]
+		received, err := l.receive(ctx, peer, &start, handle)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if stop, ok := err.(*stopError); ok {
+			return stop.err
+		}
+		if l.config.OnError != nil {
+			l.config.OnError(peer.GetAddress(), err)
+		}
+		if received {
+			backoff = l.config.MinBackoff
+		}
+		l.current = (l.current + 1) % len(l.peers)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		if backoff *= 2; backoff > l.config.MaxBackoff {
+			backoff = l.config.MaxBackoff
+		}
+	}
+}
+
+// receive 从一个节点接收区块直到出错，start 随处理的区块前移，返回是否处理了新的区块
+func (l *BlockListener) receive(ctx context.Context, peer DeliverPeer, start *Start, handle func(*Block) error) (bool, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	address := peer.GetAddress()
+	client, err := peer.GetDeliverClient(ctx)
+	if err != nil {
+		return false, err
+	}
+	stream, err := l.connect(ctx, client)
+	if err != nil {
+		return false, errors.WithMessage(sdkerrors.ConnectionFailed(address, err), "error connecting to deliver")
+	}
+	env, err := seekEnvelope(l.config.ChannelID, l.signer, peer.GetCertificate(), start.seekPosition())
+	if err != nil {
+		return false, &stopError{err}
+	}
+	if err := stream.Send(env); err != nil {
+		return false, errors.WithMessage(sdkerrors.ConnectionFailed(address, err), "error sending deliver seek info envelope")
+	}
+
+	received := false
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return received, errors.WithMessage(sdkerrors.ConnectionFailed(address, err), "error receiving from deliver")
+		}
+		block := &Block{Peer: address}
+		switch r := resp.Type.(type) {
+		case *pb.DeliverResponse_Block:
+			block.Block = r.Block
+			block.Number = r.Block.GetHeader().GetNumber()
+		case *pb.DeliverResponse_FilteredBlock:
+			block.FilteredBlock = r.FilteredBlock
+			block.Number = r.FilteredBlock.GetNumber()
+		case *pb.DeliverResponse_BlockAndPrivateData:
+			block.Block = r.BlockAndPrivateData.GetBlock()
+			block.PrivateData = r.BlockAndPrivateData.GetPrivateDataMap()
+			block.Number = block.Block.GetHeader().GetNumber()
+		case *pb.DeliverResponse_Status:
+			err := errors.Errorf("deliver from %s completed with status (%s)", address, r.Status)
+			if r.Status == cb.Status_FORBIDDEN || r.Status == cb.Status_BAD_REQUEST {
+				return received, &stopError{err}
+			}
+			return received, err
+		default:
+			return received, errors.Errorf("received unexpected response type (%T) from %s", r, address)
+		}
+		// 重连后节点可能重复发送已处理的区块
+		if start.Type == StartBlock && block.Number < start.BlockNumber {
+			continue
+		}
+
+		if err := handle(block); err != nil {
+			return received, &stopError{errors.WithMessagef(err, "handle block %d", block.Number)}
+		}
+		received = true
+		*start = Start{Type: StartBlock, BlockNumber: block.Number + 1}
+		if err := l.config.Checkpointer.Save(start.BlockNumber); err != nil {
+			return received, &stopError{errors.WithMessagef(err, "save checkpoint of block %d", block.Number)}
+		}
+	}
+}
+
+func (l *BlockListener) connect(ctx context.Context, client pb.DeliverClient) (blockStream, error) {
+	switch l.config.Type {
+	case FilteredBlock:
+		return client.DeliverFiltered(ctx)
+	case PrivateDataBlock:
+		return client.DeliverWithPrivateData(ctx)
+	}
+	return client.Deliver(ctx)
+}
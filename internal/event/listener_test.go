@@ -0,0 +1,253 @@
+package event
+
+import (
+	"context"
+	"crypto/tls"
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+	"github.com/godzilla-s/fabricsdk-go/internal/utils"
+	"github.com/golang/protobuf/proto"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	ab "github.com/hyperledger/fabric-protos-go/orderer"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testSigner(t *testing.T) cryptoutil.Signer {
+	id, err := cryptoutil.LoadMSPDir("../../example/testdata/crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/msp", "Org1MSP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := id.NewSigner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+// fakePeer 从请求的区块开始发送高度为 height 的账本，limit 不为0时每个连接发送 limit 个区块后断开
+type fakePeer struct {
+	address string
+	height  uint64
+	limit   int
+	down    bool
+	status  cb.Status
+	// starts 每次连接请求的起始区块
+	starts []uint64
+}
+
+func (p *fakePeer) GetDeliverClient(context.Context) (pb.DeliverClient, error) {
+	if p.down {
+		return nil, errors.Errorf("%s is down", p.address)
+	}
+	return p, nil
+}
+
+func (p *fakePeer) GetCertificate() tls.Certificate { return tls.Certificate{} }
+
+func (p *fakePeer) GetAddress() string { return p.address }
+
+func (p *fakePeer) Deliver(context.Context, ...grpc.CallOption) (pb.Deliver_DeliverClient, error) {
+	return &fakeStream{peer: p, blockType: FullBlock}, nil
+}
+
+func (p *fakePeer) DeliverFiltered(context.Context, ...grpc.CallOption) (pb.Deliver_DeliverFilteredClient, error) {
+	return &fakeStream{peer: p, blockType: FilteredBlock}, nil
+}
+
+func (p *fakePeer) DeliverWithPrivateData(context.Context, ...grpc.CallOption) (pb.Deliver_DeliverWithPrivateDataClient, error) {
+	return &fakeStream{peer: p, blockType: PrivateDataBlock}, nil
+}
+
+type fakeStream struct {
+	grpc.ClientStream
+	peer      *fakePeer
+	blockType BlockType
+	next      uint64
+	sent      int
+}
+
+func (s *fakeStream) Send(env *cb.Envelope) error {
+	payload, err := utils.UnmarshalPayload(env.Payload)
+	if err != nil {
+		return err
+	}
+	seekInfo := &ab.SeekInfo{}
+	if err := proto.Unmarshal(payload.Data, seekInfo); err != nil {
+		return err
+	}
+	switch start := seekInfo.Start.Type.(type) {
+	case *ab.SeekPosition_Specified:
+		s.next = start.Specified.Number
+	case *ab.SeekPosition_Newest:
+		s.next = s.peer.height - 1
+	}
+	s.peer.starts = append(s.peer.starts, s.next)
+	return nil
+}
+
+func (s *fakeStream) Recv() (*pb.DeliverResponse, error) {
+	if s.peer.status != cb.Status_UNKNOWN {
+		return &pb.DeliverResponse{Type: &pb.DeliverResponse_Status{Status: s.peer.status}}, nil
+	}
+	if (s.peer.limit > 0 && s.sent == s.peer.limit) || s.next >= s.peer.height {
+		return nil, io.EOF
+	}
+	number := s.next
+	s.next++
+	s.sent++
+	switch s.blockType {
+	case FilteredBlock:
+		return &pb.DeliverResponse{Type: &pb.DeliverResponse_FilteredBlock{FilteredBlock: &pb.FilteredBlock{Number: number}}}, nil
+	case PrivateDataBlock:
+		return &pb.DeliverResponse{Type: &pb.DeliverResponse_BlockAndPrivateData{BlockAndPrivateData: &pb.BlockAndPrivateData{
+			Block: &cb.Block{Header: &cb.BlockHeader{Number: number}},
+		}}}, nil
+	}
+	return &pb.DeliverResponse{Type: &pb.DeliverResponse_Block{Block: &cb.Block{Header: &cb.BlockHeader{Number: number}}}}, nil
+}
+
+func TestBlockListenerFailover(t *testing.T) {
+	peer0 := &fakePeer{address: "peer0", height: 10, limit: 3}
+	peer1 := &fakePeer{address: "peer1", height: 10, limit: 3, down: true}
+	peer2 := &fakePeer{address: "peer2", height: 10, limit: 3}
+	var failures []string
+	listener, err := NewBlockListener(testSigner(t), []DeliverPeer{peer0, peer1, peer2}, ListenerConfig{
+		ChannelID:    "mychannel",
+		Type:         FilteredBlock,
+		Start:        Start{Type: StartOldest},
+		MinBackoff:   time.Millisecond,
+		MaxBackoff:   4 * time.Millisecond,
+		OnError:      func(peer string, err error) { failures = append(failures, peer) },
+		Checkpointer: NewMemoryCheckpointer(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var numbers []uint64
+	stop := errors.New("stop")
+	err = listener.Run(context.Background(), func(block *Block) error {
+		if block.FilteredBlock == nil {
+			t.Fatalf("expected filtered block from %s", block.Peer)
+		}
+		numbers = append(numbers, block.Number)
+		if block.Number == 7 {
+			return stop
+		}
+		return nil
+	})
+	if errors.Cause(err) != stop {
+		t.Fatalf("expected error of handler, got %v", err)
+	}
+	for i, n := range numbers {
+		if n != uint64(i) {
+			t.Fatalf("expected blocks in order without duplicates, got %v", numbers)
+		}
+	}
+	if len(numbers) != 8 {
+		t.Fatalf("expected blocks 0 to 7, got %v", numbers)
+	}
+	if len(peer2.starts) != 1 || peer2.starts[0] != 3 || len(peer0.starts) != 2 || peer0.starts[1] != 6 {
+		t.Fatalf("expected to resume from the next block, got %v and %v", peer0.starts, peer2.starts)
+	}
+	if len(failures) != 3 || failures[1] != "peer1" {
+		t.Fatalf("unexpected failures %v", failures)
+	}
+}
+
+func TestBlockListenerCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	checkpointer := NewFileCheckpointer(filepath.Join(dir, "mychannel.json"))
+	if _, ok, err := checkpointer.Load(); ok || err != nil {
+		t.Fatalf("expected no checkpoint, got %v %v", ok, err)
+	}
+
+	run := func(peer *fakePeer, last uint64) []uint64 {
+		listener, err := NewBlockListener(testSigner(t), []DeliverPeer{peer}, ListenerConfig{
+			ChannelID:    "mychannel",
+			Type:         PrivateDataBlock,
+			Start:        Start{Type: StartNewest},
+			Checkpointer: checkpointer,
+			MinBackoff:   time.Millisecond,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var numbers []uint64
+		err = listener.Run(ctx, func(block *Block) error {
+			numbers = append(numbers, block.Number)
+			if block.Number == last {
+				cancel()
+			}
+			return nil
+		})
+		if err != context.Canceled {
+			t.Fatalf("expected context canceled, got %v", err)
+		}
+		return numbers
+	}
+
+	if numbers := run(&fakePeer{address: "peer0", height: 5}, 4); len(numbers) != 1 {
+		t.Fatalf("expected newest block only, got %v", numbers)
+	}
+	if next, ok, err := checkpointer.Load(); !ok || err != nil || next != 5 {
+		t.Fatalf("expected checkpoint of block 5, got %d %v %v", next, ok, err)
+	}
+	if numbers := run(&fakePeer{address: "peer0", height: 8}, 7); len(numbers) != 3 || numbers[0] != 5 {
+		t.Fatalf("expected to resume from the checkpoint, got %v", numbers)
+	}
+}
+
+func TestBlockListenerForbidden(t *testing.T) {
+	peer := &fakePeer{address: "peer0", height: 1, status: cb.Status_FORBIDDEN}
+	listener, err := NewBlockListener(testSigner(t), []DeliverPeer{peer}, ListenerConfig{ChannelID: "mychannel", Checkpointer: NewMemoryCheckpointer()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := listener.Run(context.Background(), func(*Block) error { return nil }); err == nil {
+		t.Fatal("expected error of forbidden")
+	}
+}
+
+func TestBlockListenerDefaultCheckpointer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "checkpoint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	listener, err := NewBlockListener(testSigner(t), []DeliverPeer{&fakePeer{address: "peer0", height: 3}}, ListenerConfig{
+		ChannelID:     "mychannel",
+		Type:          FilteredBlock,
+		Start:         Start{Type: StartOldest},
+		CheckpointDir: dir,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	listener.Run(ctx, func(block *Block) error {
+		if block.Number == 2 {
+			cancel()
+		}
+		return nil
+	})
+
+	next, ok, err := NewFileCheckpointer(filepath.Join(dir, "mychannel-filtered.json")).Load()
+	if !ok || err != nil || next != 3 {
+		t.Fatalf("expected checkpoint of block 3 in the checkpoint dir, got %d %v %v", next, ok, err)
+	}
+}
@@ -1,9 +1,12 @@
 package utils
 
 import (
+	"crypto/sha256"
+	"encoding/asn1"
 	"github.com/golang/protobuf/proto"
 	cb "github.com/hyperledger/fabric-protos-go/common"
 	"github.com/pkg/errors"
+	"math/big"
 )
 
 // GetMetadataFromBlock retrieves metadata at the specified index.
@@ -53,3 +56,32 @@ func GetLastConfigIndexFromBlock(block *cb.Block) (uint64, error) {
 	return obm.LastConfig.Index, nil
 }
 
+
+type asn1Header struct {
+	Number       *big.Int
+	PreviousHash []byte
+	DataHash     []byte
+}
+
+// BlockHeaderBytes returns the ASN.1 marshaled representation of the block header
+func BlockHeaderBytes(b *cb.BlockHeader) []byte {
+	result, err := asn1.Marshal(asn1Header{
+		PreviousHash: b.PreviousHash,
+		DataHash:     b.DataHash,
+		Number:       new(big.Int).SetUint64(b.Number),
+	})
+	if err != nil {
+		// Errors should only arise for types which cannot be encoded, since the
+		// BlockHeader type is known a-priori to contain only encodable types, an
+		// error here is fatal and should not be propagated
+		panic(err)
+	}
+	return result
+}
+
+// BlockHeaderHash returns the hash of the block header, which is what the
+// next block refers to as previous hash and what qscc GetBlockByHash expects
+func BlockHeaderHash(b *cb.BlockHeader) []byte {
+	sum := sha256.Sum256(BlockHeaderBytes(b))
+	return sum[:]
+}
@@ -0,0 +1,189 @@
+package network
+
+import (
+	"github.com/pkg/errors"
+	"sort"
+)
+
+// Network is the model of a fabric network loaded from a connection profile.
+// Certificates referenced by path in the profile are already read into PEM bytes
+type Network struct {
+	Name    string
+	Version string
+	// ClientOrganization is the organization of the client, from the client section
+	ClientOrganization     string
+	Organizations          map[string]*Organization
+	Peers                  map[string]*Peer
+	Orderers               map[string]*Orderer
+	CertificateAuthorities map[string]*CertificateAuthority
+	Channels               map[string]*Channel
+}
+
+type Organization struct {
+	Name  string
+	MSPID string
+	// names of the peers, orderers and CAs of the organization
+	Peers                  []string
+	Orderers               []string
+	CertificateAuthorities []string
+	// PEM-encoded admin identity of the organization, optional
+	AdminPrivateKey []byte
+	SignedCert      []byte
+}
+
+// Endpoint is a grpc endpoint of a peer or an orderer
+type Endpoint struct {
+	Name string
+	// address of the node, host:port without the grpc(s):// scheme
+	URL string
+	// TLS server name, from the ssl-target-name-override or hostnameOverride grpc option
+	HostName string
+	// PEM-encoded TLS root certificate, nil if TLS is disabled
+	TLSRootCert []byte
+	GRPCOptions map[string]interface{}
+}
+
+type Peer struct {
+	Endpoint
+}
+
+type Orderer struct {
+	Endpoint
+}
+
+type CertificateAuthority struct {
+	Name   string
+	URL    string
+	CAName string
+	// PEM-encoded TLS root certificates
+	TLSRootCerts [][]byte
+	EnrollID     string
+	EnrollSecret string
+}
+
+type Channel struct {
+	Name     string
+	Orderers []string
+	Peers    map[string]*ChannelPeer
+}
+
+// ChannelPeer are the roles of a peer in a channel, all of them default to true
+type ChannelPeer struct {
+	EndorsingPeer  bool
+	ChaincodeQuery bool
+	LedgerQuery    bool
+	EventSource    bool
+}
+
+// Peer returns the peer of the name
+func (n *Network) Peer(name string) (*Peer, error) {
+	p, ok := n.Peers[name]
+	if !ok {
+		return nil, errors.Errorf("peer %s not found in network", name)
+	}
+	return p, nil
+}
+
+// Orderer returns the orderer of the name
+func (n *Network) Orderer(name string) (*Orderer, error) {
+	o, ok := n.Orderers[name]
+	if !ok {
+		return nil, errors.Errorf("orderer %s not found in network", name)
+	}
+	return o, nil
+}
+
+// Organization returns the organization of the name or MSP ID
+func (n *Network) Organization(nameOrMSPID string) (*Organization, error) {
+	if org, ok := n.Organizations[nameOrMSPID]; ok {
+		return org, nil
+	}
+	for _, org := range n.Organizations {
+		if org.MSPID == nameOrMSPID {
+			return org, nil
+		}
+	}
+	return nil, errors.Errorf("organization %s not found in network", nameOrMSPID)
+}
+
+// PeersOfOrganization returns the peers of the organization of the name or MSP ID
+func (n *Network) PeersOfOrganization(nameOrMSPID string) ([]*Peer, error) {
+	org, err := n.Organization(nameOrMSPID)
+	if err != nil {
+		return nil, err
+	}
+	peers := make([]*Peer, 0, len(org.Peers))
+	for _, name := range org.Peers {
+		p, err := n.Peer(name)
+		if err != nil {
+			return nil, err
+		}
+		peers = append(peers, p)
+	}
+	return peers, nil
+}
+
+// OrderersOfOrganization returns the orderers of the organization of the name or MSP ID
+func (n *Network) OrderersOfOrganization(nameOrMSPID string) ([]*Orderer, error) {
+	org, err := n.Organization(nameOrMSPID)
+	if err != nil {
+		return nil, err
+	}
+	orderers := make([]*Orderer, 0, len(org.Orderers))
+	for _, name := range org.Orderers {
+		o, err := n.Orderer(name)
+		if err != nil {
+			return nil, err
+		}
+		orderers = append(orderers, o)
+	}
+	return orderers, nil
+}
+
+// ChannelPeers returns the peers of the channel which satisfy the filter, all
+// peers of the channel if filter is nil. Peers are sorted by name
+func (n *Network) ChannelPeers(channelID string, filter func(*ChannelPeer) bool) ([]*Peer, error) {
+	ch, ok := n.Channels[channelID]
+	if !ok {
+		return nil, errors.Errorf("channel %s not found in network", channelID)
+	}
+	names := make([]string, 0, len(ch.Peers))
+	for name, roles := range ch.Peers {
+		if filter == nil || filter(roles) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	peers := make([]*Peer, 0, len(names))
+	for _, name := range names {
+		p, err := n.Peer(name)
+		if err != nil {
+			return nil, err
+		}
+		peers = append(peers, p)
+	}
+	return peers, nil
+}
+
+// EndorsingPeers returns the endorsing peers of the channel
+func (n *Network) EndorsingPeers(channelID string) ([]*Peer, error) {
+	return n.ChannelPeers(channelID, func(p *ChannelPeer) bool { return p.EndorsingPeer })
+}
+
+// ChannelOrderers returns the orderers of the channel
+func (n *Network) ChannelOrderers(channelID string) ([]*Orderer, error) {
+	ch, ok := n.Channels[channelID]
+	if !ok {
+		return nil, errors.Errorf("channel %s not found in network", channelID)
+	}
+	orderers := make([]*Orderer, 0, len(ch.Orderers))
+	for _, name := range ch.Orderers {
+		o, err := n.Orderer(name)
+		if err != nil {
+			return nil, err
+		}
+		orderers = append(orderers, o)
+	}
+	return orderers, nil
+}
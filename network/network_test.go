@@ -0,0 +1,62 @@
+package network
+
+import (
+	"testing"
+)
+
+func TestLoadFile(t *testing.T) {
+	n, err := LoadFile("testdata/connection-profile.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := n.Peer("peer0.org1.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.URL != "localhost:7051" || p.HostName != "peer0.org1.example.com" || len(p.TLSRootCert) == 0 {
+		t.Fatalf("unexpected peer %+v", p)
+	}
+	if p, _ := n.Peer("peer1.org1.example.com"); p.TLSRootCert != nil {
+		t.Fatal("tls should be disabled for grpc url")
+	}
+
+	orderers, err := n.OrderersOfOrganization("OrdererMSP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orderers) != 1 || orderers[0].HostName != "orderer.example.com" {
+		t.Fatalf("unexpected orderers %+v", orderers)
+	}
+
+	endorsers, err := n.EndorsingPeers("mychannel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(endorsers) != 1 || endorsers[0].Name != "peer0.org1.example.com" {
+		t.Fatalf("unexpected endorsing peers %+v", endorsers)
+	}
+}
+
+func TestLoad_JSON(t *testing.T) {
+	profile := `{
+		"name": "test-network",
+		"organizations": {"Org1": {"mspid": "Org1MSP", "peers": ["peer0"]}},
+		"peers": {"peer0": {"url": "grpcs://localhost:7051", "tlsCACerts": {"pem": "-----BEGIN CERTIFICATE-----"}}}
+	}`
+	n, err := Load([]byte(profile), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	peers, err := n.PeersOfOrganization("Org1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(peers) != 1 || string(peers[0].TLSRootCert) != "-----BEGIN CERTIFICATE-----" {
+		t.Fatalf("unexpected peers %+v", peers)
+	}
+
+	if _, err := Load([]byte(`{"organizations": {"Org1": {"mspid": "Org1MSP", "peers": ["peer0"]}}}`), ""); err == nil {
+		t.Fatal("expected error for unknown peer")
+	}
+}
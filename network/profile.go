@@ -0,0 +1,302 @@
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// profile is the fabric connection profile, in YAML or JSON
+type profile struct {
+	Name    string `yaml:"name" json:"name"`
+	Version string `yaml:"version" json:"version"`
+	Client  struct {
+		Organization string `yaml:"organization" json:"organization"`
+	} `yaml:"client" json:"client"`
+	Organizations          map[string]organizationConfig `yaml:"organizations" json:"organizations"`
+	Peers                  map[string]endpointConfig     `yaml:"peers" json:"peers"`
+	Orderers               map[string]endpointConfig     `yaml:"orderers" json:"orderers"`
+	CertificateAuthorities map[string]caConfig           `yaml:"certificateAuthorities" json:"certificateAuthorities"`
+	Channels               map[string]channelConfig      `yaml:"channels" json:"channels"`
+}
+
+type organizationConfig struct {
+	MSPID                  string    `yaml:"mspid" json:"mspid"`
+	Peers                  []string  `yaml:"peers" json:"peers"`
+	Orderers               []string  `yaml:"orderers" json:"orderers"`
+	CertificateAuthorities []string  `yaml:"certificateAuthorities" json:"certificateAuthorities"`
+	AdminPrivateKey        pemConfig `yaml:"adminPrivateKey" json:"adminPrivateKey"`
+	SignedCert             pemConfig `yaml:"signedCert" json:"signedCert"`
+}
+
+type endpointConfig struct {
+	URL         string                 `yaml:"url" json:"url"`
+	GRPCOptions map[string]interface{} `yaml:"grpcOptions" json:"grpcOptions"`
+	TLSCACerts  pemConfig              `yaml:"tlsCACerts" json:"tlsCACerts"`
+}
+
+type caConfig struct {
+	URL        string    `yaml:"url" json:"url"`
+	CAName     string    `yaml:"caName" json:"caName"`
+	TLSCACerts pemConfig `yaml:"tlsCACerts" json:"tlsCACerts"`
+	Registrar  struct {
+		EnrollID     string `yaml:"enrollId" json:"enrollId"`
+		EnrollSecret string `yaml:"enrollSecret" json:"enrollSecret"`
+	} `yaml:"registrar" json:"registrar"`
+}
+
+type channelConfig struct {
+	Orderers []string                     `yaml:"orderers" json:"orderers"`
+	Peers    map[string]channelPeerConfig `yaml:"peers" json:"peers"`
+}
+
+type channelPeerConfig struct {
+	EndorsingPeer  *bool `yaml:"endorsingPeer" json:"endorsingPeer"`
+	ChaincodeQuery *bool `yaml:"chaincodeQuery" json:"chaincodeQuery"`
+	LedgerQuery    *bool `yaml:"ledgerQuery" json:"ledgerQuery"`
+	EventSource    *bool `yaml:"eventSource" json:"eventSource"`
+}
+
+// pemConfig is a certificate or key given inline by pem or in a file by path
+type pemConfig struct {
+	Path string  `yaml:"path" json:"path"`
+	PEM  pemList `yaml:"pem" json:"pem"`
+}
+
+// pemList accepts a single PEM string as well as a list of them
+type pemList []string
+
+func (l *pemList) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err == nil {
+		*l = pemList{s}
+		return nil
+	}
+	var list []string
+	if err := unmarshal(&list); err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
+
+func (l *pemList) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*l = pemList{s}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
+
+// LoadFile loads the connection profile of path, relative certificate paths in
+// the profile are resolved against the directory of the profile
+func LoadFile(path string) (*Network, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read connection profile")
+	}
+	return Load(data, filepath.Dir(path))
+}
+
+// Load loads a connection profile in YAML or JSON, relative certificate paths
+// in the profile are resolved against dir
+func Load(data []byte, dir string) (*Network, error) {
+	var p profile
+	var err error
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		err = json.Unmarshal(data, &p)
+	} else {
+		err = yaml.Unmarshal(data, &p)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "parse connection profile")
+	}
+	return p.toNetwork(dir)
+}
+
+func (p *profile) toNetwork(dir string) (*Network, error) {
+	n := &Network{
+		Name:                   p.Name,
+		Version:                p.Version,
+		ClientOrganization:     p.Client.Organization,
+		Organizations:          make(map[string]*Organization),
+		Peers:                  make(map[string]*Peer),
+		Orderers:               make(map[string]*Orderer),
+		CertificateAuthorities: make(map[string]*CertificateAuthority),
+		Channels:               make(map[string]*Channel),
+	}
+
+	for name, c := range p.Peers {
+		ep, err := c.toEndpoint(name, dir)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "peer %s", name)
+		}
+		n.Peers[name] = &Peer{Endpoint: *ep}
+	}
+	for name, c := range p.Orderers {
+		ep, err := c.toEndpoint(name, dir)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "orderer %s", name)
+		}
+		n.Orderers[name] = &Orderer{Endpoint: *ep}
+	}
+	for name, c := range p.CertificateAuthorities {
+		certs, err := c.TLSCACerts.load(dir)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "certificate authority %s", name)
+		}
+		n.CertificateAuthorities[name] = &CertificateAuthority{
+			Name:         name,
+			URL:          c.URL,
+			CAName:       c.CAName,
+			TLSRootCerts: certs,
+			EnrollID:     c.Registrar.EnrollID,
+			EnrollSecret: c.Registrar.EnrollSecret,
+		}
+	}
+
+	for name, c := range p.Organizations {
+		if c.MSPID == "" {
+			return nil, errors.Errorf("organization %s: mspid is required", name)
+		}
+		org := &Organization{
+			Name:                   name,
+			MSPID:                  c.MSPID,
+			Peers:                  c.Peers,
+			Orderers:               c.Orderers,
+			CertificateAuthorities: c.CertificateAuthorities,
+		}
+		var err error
+		if org.AdminPrivateKey, err = c.AdminPrivateKey.loadOne(dir); err != nil {
+			return nil, errors.WithMessagef(err, "organization %s admin private key", name)
+		}
+		if org.SignedCert, err = c.SignedCert.loadOne(dir); err != nil {
+			return nil, errors.WithMessagef(err, "organization %s signed cert", name)
+		}
+		for _, peer := range org.Peers {
+			if _, ok := n.Peers[peer]; !ok {
+				return nil, errors.Errorf("organization %s: unknown peer %s", name, peer)
+			}
+		}
+		for _, orderer := range org.Orderers {
+			if _, ok := n.Orderers[orderer]; !ok {
+				return nil, errors.Errorf("organization %s: unknown orderer %s", name, orderer)
+			}
+		}
+		for _, ca := range org.CertificateAuthorities {
+			if _, ok := n.CertificateAuthorities[ca]; !ok {
+				return nil, errors.Errorf("organization %s: unknown certificate authority %s", name, ca)
+			}
+		}
+		n.Organizations[name] = org
+	}
+	if n.ClientOrganization != "" {
+		if _, ok := n.Organizations[n.ClientOrganization]; !ok {
+			return nil, errors.Errorf("client organization %s not found", n.ClientOrganization)
+		}
+	}
+
+	for name, c := range p.Channels {
+		ch := &Channel{Name: name, Orderers: c.Orderers, Peers: make(map[string]*ChannelPeer)}
+		for _, orderer := range ch.Orderers {
+			if _, ok := n.Orderers[orderer]; !ok {
+				return nil, errors.Errorf("channel %s: unknown orderer %s", name, orderer)
+			}
+		}
+		for peer, roles := range c.Peers {
+			if _, ok := n.Peers[peer]; !ok {
+				return nil, errors.Errorf("channel %s: unknown peer %s", name, peer)
+			}
+			ch.Peers[peer] = &ChannelPeer{
+				EndorsingPeer:  boolOrTrue(roles.EndorsingPeer),
+				ChaincodeQuery: boolOrTrue(roles.ChaincodeQuery),
+				LedgerQuery:    boolOrTrue(roles.LedgerQuery),
+				EventSource:    boolOrTrue(roles.EventSource),
+			}
+		}
+		n.Channels[name] = ch
+	}
+	return n, nil
+}
+
+func (c *endpointConfig) toEndpoint(name, dir string) (*Endpoint, error) {
+	if c.URL == "" {
+		return nil, errors.New("url is required")
+	}
+	ep := &Endpoint{Name: name, GRPCOptions: c.GRPCOptions}
+
+	useTLS := true
+	switch {
+	case strings.HasPrefix(c.URL, "grpcs://"):
+		ep.URL = strings.TrimPrefix(c.URL, "grpcs://")
+	case strings.HasPrefix(c.URL, "grpc://"):
+		ep.URL = strings.TrimPrefix(c.URL, "grpc://")
+		useTLS = false
+	default:
+		ep.URL = c.URL
+	}
+
+	for _, key := range []string{"ssl-target-name-override", "hostnameOverride"} {
+		if v, ok := c.GRPCOptions[key].(string); ok && v != "" {
+			ep.HostName = v
+			break
+		}
+	}
+
+	if useTLS {
+		cert, err := c.TLSCACerts.loadOne(dir)
+		if err != nil {
+			return nil, errors.WithMessage(err, "tls ca certs")
+		}
+		if cert == nil && strings.HasPrefix(c.URL, "grpcs://") {
+			return nil, errors.New("tls ca certs are required for grpcs url")
+		}
+		ep.TLSRootCert = cert
+	}
+	return ep, nil
+}
+
+// load returns the inline PEMs followed by the content of the file of path
+func (c pemConfig) load(dir string) ([][]byte, error) {
+	var pems [][]byte
+	for _, p := range c.PEM {
+		if strings.TrimSpace(p) != "" {
+			pems = append(pems, []byte(p))
+		}
+	}
+	if c.Path != "" {
+		path := c.Path
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "read %s", c.Path)
+		}
+		pems = append(pems, data)
+	}
+	return pems, nil
+}
+
+// loadOne concatenates the PEMs of load, nil if none is given
+func (c pemConfig) loadOne(dir string) ([]byte, error) {
+	pems, err := c.load(dir)
+	if err != nil || len(pems) == 0 {
+		return nil, err
+	}
+	return bytes.Join(pems, []byte("\n")), nil
+}
+
+func boolOrTrue(b *bool) bool {
+	return b == nil || *b
+}
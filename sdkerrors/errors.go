@@ -0,0 +1,229 @@
+package sdkerrors
+
+import (
+	"context"
+	"fmt"
+	cb "github.com/hyperledger/fabric-protos-go/common"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+// Code is the kind of an error, the values are stable and equal to the
+// ErrorCode enum of the gateway protos
+type Code int32
+
+const (
+	OK Code = iota
+	Unknown
+	// EndorsementFailure is an error or unsuccessful response of an endorsing peer
+	EndorsementFailure
+	// ProposalMismatch means the endorsing peers returned different proposal response payloads
+	ProposalMismatch
+	// OrdererNACK means the orderer rejected the transaction with a status
+	OrdererNACK
+	// ValidationFailure means the transaction was invalidated by the committing peer
+	ValidationFailure
+	// MVCCConflict means the transaction was invalidated by a read conflict, it can be retried
+	MVCCConflict
+	// PolicyFailure means an endorsement or channel policy was not satisfied
+	PolicyFailure
+	Timeout
+	ConnectionFailure
+)
+
+var codeNames = map[Code]string{
+	OK:                 "OK",
+	Unknown:            "UNKNOWN_ERROR",
+	EndorsementFailure: "ENDORSEMENT_FAILURE",
+	ProposalMismatch:   "PROPOSAL_MISMATCH",
+	OrdererNACK:        "ORDERER_NACK",
+	ValidationFailure:  "VALIDATION_FAILURE",
+	MVCCConflict:       "MVCC_CONFLICT",
+	PolicyFailure:      "POLICY_FAILURE",
+	Timeout:            "TIMEOUT",
+	ConnectionFailure:  "CONNECTION_FAILURE",
+}
+
+func (c Code) String() string {
+	if name, ok := codeNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("CODE(%d)", int32(c))
+}
+
+// Error is an error of the SDK with a stable code, so that callers can branch
+// on the kind of the error instead of parsing the message
+type Error struct {
+	Code    Code
+	Message string
+	// Endpoint is the address of the peer or orderer the error comes from
+	Endpoint string
+	// Status is the status returned by the peer or orderer, if any
+	Status int32
+	TxID   string
+	// ValidationCode is the pb.TxValidationCode of an invalidated transaction
+	ValidationCode pb.TxValidationCode
+	// Errors are the errors of every failed peer of an endorsement failure
+	Errors []*Error
+
+	cause error
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	b.WriteString(e.Message)
+	if e.Endpoint != "" {
+		b.WriteString(" at ")
+		b.WriteString(e.Endpoint)
+	}
+	for _, pe := range e.Errors {
+		b.WriteString("; ")
+		b.WriteString(pe.Error())
+	}
+	if e.cause != nil {
+		b.WriteString(": ")
+		b.WriteString(e.cause.Error())
+	}
+	return b.String()
+}
+
+// Unwrap returns the underlying error
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// WithCause sets the underlying error of e, e.g. a sentinel error the failure
+// is recognized as, so that errors.Is matches it while the code is kept
+func (e *Error) WithCause(err error) *Error {
+	e.cause = err
+	return e
+}
+
+// New creates an error of the code
+func New(code Code, format string, args ...interface{}) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// Wrap creates an error of the code caused by err
+func Wrap(err error, code Code, format string, args ...interface{}) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...), cause: err}
+}
+
+// As returns the SDK error in the chain of err
+func As(err error) (*Error, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e, true
+	}
+	return nil, false
+}
+
+// CodeOf returns the code of err, a context deadline is reported as Timeout
+// even if it is not wrapped in an SDK error
+func CodeOf(err error) Code {
+	if err == nil {
+		return OK
+	}
+	if e, ok := As(err); ok {
+		return e.Code
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return Timeout
+	}
+	return Unknown
+}
+
+// Is reports whether err is of the code
+func Is(err error, code Code) bool {
+	return CodeOf(err) == code
+}
+
+// EndorsementFailed is the failure of a single endorsing peer
+func EndorsementFailed(endpoint string, status int32, message string) *Error {
+	return &Error{
+		Code:     EndorsementFailure,
+		Message:  fmt.Sprintf("endorsement failed with status %d - %s", status, message),
+		Endpoint: endpoint,
+		Status:   status,
+	}
+}
+
+// ProposalFailed classifies the error of a peer at endpoint failed to process a
+// proposal: an unavailable peer is a connection failure, an expired deadline a
+// timeout and any other error an endorsement failure
+func ProposalFailed(endpoint string, err error) *Error {
+	var e *Error
+	switch status.Code(err) {
+	case codes.Unavailable:
+		return ConnectionFailed(endpoint, err)
+	case codes.DeadlineExceeded, codes.Canceled:
+		e = TimedOut(err, "endorsement timed out")
+	default:
+		e = Wrap(err, EndorsementFailure, "endorsement failed")
+	}
+	e.Endpoint = endpoint
+	return e
+}
+
+// EndorsementsFailed groups the failures of the endorsing peers
+func EndorsementsFailed(errs []*Error) *Error {
+	return &Error{
+		Code:    EndorsementFailure,
+		Message: fmt.Sprintf("endorsement failed on %d peer(s)", len(errs)),
+		Errors:  errs,
+	}
+}
+
+// ProposalResponsesMismatch means the payload endorsed by the peer at endpoint
+// differs from the one of the first endorsement
+func ProposalResponsesMismatch(endpoint string) *Error {
+	return &Error{Code: ProposalMismatch, Message: "proposal response payloads do not match", Endpoint: endpoint}
+}
+
+// ConnectionFailed is the failure to connect or talk to a peer or orderer
+func ConnectionFailed(endpoint string, err error) *Error {
+	return &Error{Code: ConnectionFailure, Message: "connection failed", Endpoint: endpoint, cause: err}
+}
+
+// TimedOut reports that waiting for the operation exceeded its deadline
+func TimedOut(err error, format string, args ...interface{}) *Error {
+	return Wrap(err, Timeout, format, args...)
+}
+
+// OrdererRejected is the NACK of the orderer to a broadcast envelope, a
+// FORBIDDEN status is a policy failure
+func OrdererRejected(endpoint string, status cb.Status, info string) *Error {
+	code := OrdererNACK
+	if status == cb.Status_FORBIDDEN {
+		code = PolicyFailure
+	}
+	return &Error{
+		Code:     code,
+		Message:  fmt.Sprintf("orderer rejected the envelope with status %s - %s", status, info),
+		Endpoint: endpoint,
+		Status:   int32(status),
+	}
+}
+
+// TxInvalidated is a transaction invalidated by the committing peer. Read
+// conflicts are reported as MVCCConflict and endorsement policy failures as
+// PolicyFailure
+func TxInvalidated(txID, endpoint string, validationCode pb.TxValidationCode) *Error {
+	code := ValidationFailure
+	switch validationCode {
+	case pb.TxValidationCode_MVCC_READ_CONFLICT, pb.TxValidationCode_PHANTOM_READ_CONFLICT:
+		code = MVCCConflict
+	case pb.TxValidationCode_ENDORSEMENT_POLICY_FAILURE:
+		code = PolicyFailure
+	}
+	return &Error{
+		Code:           code,
+		Message:        fmt.Sprintf("transaction %s invalidated with status %s", txID, validationCode),
+		Endpoint:       endpoint,
+		TxID:           txID,
+		ValidationCode: validationCode,
+	}
+}
@@ -0,0 +1,43 @@
+package sdkerrors
+
+import (
+	"context"
+	pb "github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/pkg/errors"
+	"testing"
+)
+
+func TestCodeOf(t *testing.T) {
+	tests := []struct {
+		err  error
+		code Code
+	}{
+		{nil, OK},
+		{errors.New("plain"), Unknown},
+		{errors.WithMessage(context.DeadlineExceeded, "wait"), Timeout},
+		{errors.WithMessage(TxInvalidated("tx1", "peer0", pb.TxValidationCode_MVCC_READ_CONFLICT), "commit"), MVCCConflict},
+		{TxInvalidated("tx1", "peer0", pb.TxValidationCode_ENDORSEMENT_POLICY_FAILURE), PolicyFailure},
+		{TxInvalidated("tx1", "peer0", pb.TxValidationCode_BAD_PAYLOAD), ValidationFailure},
+		{ConnectionFailed("peer0", errors.New("refused")), ConnectionFailure},
+	}
+	for _, test := range tests {
+		if code := CodeOf(test.err); code != test.code {
+			t.Errorf("CodeOf(%v) = %s, expected %s", test.err, code, test.code)
+		}
+	}
+}
+
+func TestEndorsementsFailed(t *testing.T) {
+	err := errors.WithMessage(EndorsementsFailed([]*Error{
+		EndorsementFailed("peer0", 500, "chaincode error"),
+		ConnectionFailed("peer1", errors.New("refused")),
+	}), "invoke")
+
+	e, ok := As(err)
+	if !ok || e.Code != EndorsementFailure || len(e.Errors) != 2 {
+		t.Fatalf("unexpected error %#v", e)
+	}
+	if e.Errors[0].Endpoint != "peer0" || e.Errors[0].Status != 500 || e.Errors[1].Code != ConnectionFailure {
+		t.Fatalf("unexpected peer errors %v", e.Errors)
+	}
+}
@@ -0,0 +1,48 @@
+package wallet
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+)
+
+// AnyCaller in the callers of a label allows every authenticated caller to
+// sign with the identity of the label
+const AnyCaller = "*"
+
+// AccessList maps an identity label to the callers allowed to sign with the
+// identity, a caller is named by the Common Name of its TLS client certificate
+type AccessList map[string][]string
+
+// Allowed reports whether caller may sign with the identity of label, an
+// unauthenticated caller is never allowed
+func (a AccessList) Allowed(label, caller string) bool {
+	if caller == "" {
+		return false
+	}
+	for _, c := range a[label] {
+		if c == caller || c == AnyCaller {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadAccessList reads an access list from a JSON file mapping every label to
+// its callers, e.g. {"org1-admin": ["ops-client"], "org1-user": ["*"]}
+func LoadAccessList(path string) (AccessList, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read wallet access list")
+	}
+	access := AccessList{}
+	if err := json.Unmarshal(data, &access); err != nil {
+		return nil, errors.Wrapf(err, "invalid wallet access list %s", path)
+	}
+	for label := range access {
+		if err := validateLabel(label); err != nil {
+			return nil, err
+		}
+	}
+	return access, nil
+}
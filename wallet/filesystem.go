@@ -0,0 +1,144 @@
+package wallet
+
+import (
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	idFileExt     = ".id"
+	x509Type      = "X.509"
+	idFileVersion = 1
+)
+
+// idFile is the on-disk format of an identity, <label>.id in the wallet directory
+type idFile struct {
+	Version     int           `json:"version"`
+	MspID       string        `json:"mspId"`
+	Type        string        `json:"type"`
+	Credentials idCredentials `json:"credentials"`
+}
+
+type idCredentials struct {
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"privateKey"`
+}
+
+type fileSystemWallet struct {
+	dir string
+}
+
+// NewFileSystemWallet creates a wallet which stores every identity as a
+// <label>.id JSON file in dir, the directory is created if it does not exist
+func NewFileSystemWallet(dir string) (Wallet, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "create wallet directory %s", dir)
+	}
+	return &fileSystemWallet{dir: dir}, nil
+}
+
+func (w *fileSystemWallet) path(label string) string {
+	return filepath.Join(w.dir, label+idFileExt)
+}
+
+func (w *fileSystemWallet) Put(label string, id *Identity) error {
+	if err := validate(label, id); err != nil {
+		return err
+	}
+	data, err := json.Marshal(&idFile{
+		Version: idFileVersion,
+		MspID:   id.MspID,
+		Type:    x509Type,
+		Credentials: idCredentials{
+			Certificate: string(id.Cert),
+			PrivateKey:  string(id.Key),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that a reader never sees a partial identity
+	tmp, err := ioutil.TempFile(w.dir, label+".tmp")
+	if err != nil {
+		return errors.Wrap(err, "create identity file")
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "write identity file")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "write identity file")
+	}
+	return errors.Wrap(os.Rename(tmp.Name(), w.path(label)), "write identity file")
+}
+
+func (w *fileSystemWallet) Get(label string) (*Identity, error) {
+	if err := validateLabel(label); err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadFile(w.path(label))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "read identity %s", label)
+	}
+
+	var f idFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, errors.Wrapf(err, "parse identity %s", label)
+	}
+	if f.Type != "" && f.Type != x509Type {
+		return nil, errors.Errorf("unsupported identity type %s", f.Type)
+	}
+	return &Identity{
+		MspID: f.MspID,
+		Cert:  []byte(f.Credentials.Certificate),
+		Key:   []byte(f.Credentials.PrivateKey),
+	}, nil
+}
+
+func (w *fileSystemWallet) Remove(label string) error {
+	if err := validateLabel(label); err != nil {
+		return err
+	}
+	err := os.Remove(w.path(label))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "remove identity %s", label)
+	}
+	return nil
+}
+
+func (w *fileSystemWallet) List() ([]string, error) {
+	files, err := ioutil.ReadDir(w.dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "read wallet directory")
+	}
+	var labels []string
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), idFileExt) {
+			continue
+		}
+		labels = append(labels, strings.TrimSuffix(f.Name(), idFileExt))
+	}
+	sort.Strings(labels)
+	return labels, nil
+}
+
+func (w *fileSystemWallet) Exists(label string) (bool, error) {
+	if err := validateLabel(label); err != nil {
+		return false, err
+	}
+	_, err := os.Stat(w.path(label))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,69 @@
+package wallet
+
+import (
+	"sort"
+	"sync"
+)
+
+type memoryWallet struct {
+	mutex      sync.RWMutex
+	identities map[string]*Identity
+}
+
+// NewInMemoryWallet creates a wallet which holds identities in memory only
+func NewInMemoryWallet() Wallet {
+	return &memoryWallet{identities: make(map[string]*Identity)}
+}
+
+func (w *memoryWallet) Put(label string, id *Identity) error {
+	if err := validate(label, id); err != nil {
+		return err
+	}
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.identities[label] = copyIdentity(id)
+	return nil
+}
+
+func (w *memoryWallet) Get(label string) (*Identity, error) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	id, ok := w.identities[label]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return copyIdentity(id), nil
+}
+
+func (w *memoryWallet) Remove(label string) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	delete(w.identities, label)
+	return nil
+}
+
+func (w *memoryWallet) List() ([]string, error) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	labels := make([]string, 0, len(w.identities))
+	for label := range w.identities {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	return labels, nil
+}
+
+func (w *memoryWallet) Exists(label string) (bool, error) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	_, ok := w.identities[label]
+	return ok, nil
+}
+
+func copyIdentity(id *Identity) *Identity {
+	return &Identity{
+		MspID: id.MspID,
+		Cert:  append([]byte(nil), id.Cert...),
+		Key:   append([]byte(nil), id.Key...),
+	}
+}
@@ -0,0 +1,16 @@
+package wallet
+
+import (
+	"github.com/godzilla-s/fabricsdk-go/internal/cryptoutil"
+)
+
+// FromMSPDir reads the identity of a standard fabric MSP directory, e.g. the
+// msp folder generated by cryptogen or fabric-ca-client, so that it can be put
+// into a wallet
+func FromMSPDir(dir, mspID string) (*Identity, error) {
+	id, err := cryptoutil.LoadMSPDir(dir, mspID)
+	if err != nil {
+		return nil, err
+	}
+	return &Identity{MspID: mspID, Cert: id.SignCert, Key: id.Key}, nil
+}
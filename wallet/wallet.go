@@ -0,0 +1,60 @@
+package wallet
+
+import (
+	"github.com/pkg/errors"
+	"strings"
+)
+
+// ErrNotFound is returned when there is no identity under the label
+var ErrNotFound = errors.New("identity not found in wallet")
+
+// Identity is an X.509 identity of a fabric member
+type Identity struct {
+	// MSP ID of the organization the identity belongs to
+	MspID string
+	// PEM-encoded certificate
+	Cert []byte
+	// PEM-encoded private key
+	Key []byte
+}
+
+// Wallet stores identities under labels, so that requests refer to an
+// identity by its label instead of carrying the private key
+type Wallet interface {
+	// Put stores the identity under the label, an existing one is replaced
+	Put(label string, id *Identity) error
+	// Get returns the identity of the label, ErrNotFound if there is none
+	Get(label string) (*Identity, error)
+	// Remove deletes the identity of the label
+	Remove(label string) error
+	// List returns the labels of all identities in the wallet
+	List() ([]string, error)
+	// Exists checks whether there is an identity under the label
+	Exists(label string) (bool, error)
+}
+
+func validate(label string, id *Identity) error {
+	if err := validateLabel(label); err != nil {
+		return err
+	}
+	if id == nil {
+		return errors.New("identity is nil")
+	}
+	if id.MspID == "" {
+		return errors.New("identity msp id is required")
+	}
+	if len(id.Cert) == 0 || len(id.Key) == 0 {
+		return errors.New("identity cert and key are required")
+	}
+	return nil
+}
+
+func validateLabel(label string) error {
+	if label == "" {
+		return errors.New("identity label is required")
+	}
+	if strings.ContainsAny(label, `/\`) || label == "." || label == ".." {
+		return errors.Errorf("invalid identity label %q", label)
+	}
+	return nil
+}
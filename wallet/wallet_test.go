@@ -0,0 +1,94 @@
+package wallet
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func testWallet(t *testing.T, w Wallet) {
+	id := &Identity{MspID: "Org1MSP", Cert: []byte("cert"), Key: []byte("key")}
+	if err := w.Put("admin", id); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Put("../admin", id); err == nil {
+		t.Fatal("expected invalid label to be rejected")
+	}
+
+	got, err := w.Get("admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.MspID != id.MspID || string(got.Cert) != "cert" || string(got.Key) != "key" {
+		t.Fatalf("unexpected identity %+v", got)
+	}
+
+	labels, err := w.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(labels) != 1 || labels[0] != "admin" {
+		t.Fatalf("unexpected labels %v", labels)
+	}
+
+	if err := w.Remove("admin"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := w.Exists("admin"); ok {
+		t.Fatal("identity should be removed")
+	}
+	if _, err := w.Get("admin"); err != ErrNotFound {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestInMemoryWallet(t *testing.T) {
+	testWallet(t, NewInMemoryWallet())
+}
+
+func TestFileSystemWallet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wallet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	w, err := NewFileSystemWallet(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testWallet(t, w)
+}
+
+func TestAccessList(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wallet")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "access.json")
+	if err := ioutil.WriteFile(path, []byte(`{"admin": ["ops"], "user": ["*"]}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	access, err := LoadAccessList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		label, caller string
+		allowed       bool
+	}{
+		{"admin", "ops", true},
+		{"admin", "app", false},
+		{"admin", "", false},
+		{"user", "app", true},
+		{"user", "", false},
+		{"other", "ops", false},
+	} {
+		if got := access.Allowed(c.label, c.caller); got != c.allowed {
+			t.Errorf("Allowed(%q, %q) = %v, expected %v", c.label, c.caller, got, c.allowed)
+		}
+	}
+}